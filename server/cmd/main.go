@@ -4,15 +4,25 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/redis/go-redis/v9"
 
-	
 	"github.com/lazarevFedor/wise-task-ai/server/internal/config"
-	"github.com/lazarevFedor/wise-task-ai/server/internal/interceptors"
 	"github.com/lazarevFedor/wise-task-ai/server/internal/coreserver"
+	"github.com/lazarevFedor/wise-task-ai/server/internal/embeddings"
+	"github.com/lazarevFedor/wise-task-ai/server/internal/graceful"
+	"github.com/lazarevFedor/wise-task-ai/server/internal/interceptors"
+	"github.com/lazarevFedor/wise-task-ai/server/internal/jobstore"
+	"github.com/lazarevFedor/wise-task-ai/server/internal/queue"
+	"github.com/lazarevFedor/wise-task-ai/server/internal/search"
 
 	"github.com/lazarevFedor/wise-task-ai/server/pkg/api/core-service"
 	"github.com/lazarevFedor/wise-task-ai/server/pkg/api/llm-service"
+	"github.com/lazarevFedor/wise-task-ai/server/pkg/auth"
 	"github.com/lazarevFedor/wise-task-ai/server/pkg/db"
+	"github.com/lazarevFedor/wise-task-ai/server/pkg/health"
 	"github.com/lazarevFedor/wise-task-ai/server/pkg/logger"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
@@ -21,22 +31,21 @@ import (
 )
 
 func main() {
-
-	// Logger
 	rootCtx := context.Background()
-	rootCtx, err := logger.NewLoggerContext(rootCtx)
-	log := logger.GetLoggerFromCtx(rootCtx)
-	if err != nil {
-		log.Error(rootCtx, "Failed to make new logger", zap.Error(err))
-		return
-	}
 
 	// Config
 	cfg, err := config.NewCoreServerConfig()
 	if err != nil {
-		log.Error(rootCtx, "failed to load core configuration", zap.Error(err))
+		logger.L().Error(rootCtx, "failed to load core configuration", zap.Error(err))
+		return
+	}
+
+	// Logger
+	if err := logger.Setup(cfg.Log); err != nil {
+		logger.L().Error(rootCtx, "failed to set up logger", zap.Error(err))
 		return
 	}
+	log := logger.L()
 
 	// DB Connections
 	var dbClients *db.Clients
@@ -45,11 +54,23 @@ func main() {
 		log.Error(rootCtx, "failed to connect to Postgres", zap.Error(err))
 		return
 	}
-	defer pgClient.Close()
+
+	qdrantClient, err := db.NewQdrant(rootCtx, cfg.Qdrant)
+	if err != nil {
+		log.Error(rootCtx, "failed to connect to Qdrant", zap.Error(err))
+		return
+	}
 
 	dbClients = &db.Clients{
 		Postgres: pgClient,
+		Qdrant:   qdrantClient,
 	}
+	searchService := search.NewService(qdrantClient, embeddings.NewClient(cfg.Embeddings), nil)
+
+	// Job queue + job store, backing cmd/runner
+	redisClient := redis.NewClient(&redis.Options{Addr: cfg.Queue.Addr})
+	jobPublisher := queue.NewRedisStreamsQueue(redisClient, cfg.Queue)
+	jobStore := jobstore.NewPostgresJobStore(pgClient)
 
 	// gRPC
 	llmConnURL := fmt.Sprintf("%s:%s", cfg.LLMServer.Host, cfg.LLMServer.Port)
@@ -58,7 +79,6 @@ func main() {
 		log.Error(rootCtx, "failed to connect to LLM Service", zap.Error(err))
 		return
 	}
-	defer conn.Close()
 
 	llmClient := llm.NewLlmServiceClient(conn)
 
@@ -72,21 +92,111 @@ func main() {
 		log.Error(rootCtx, "failed to start core-server listening", zap.Error(err))
 		return
 	}
+	authenticator := auth.NewJWTAuthenticator(cfg.Auth)
+
 	server := grpc.NewServer(
-		grpc.UnaryInterceptor(interceptors.UnaryServerInterceptor(rootCtx)),
+		grpc.UnaryInterceptor(interceptors.ChainUnary(
+			interceptors.RequestIDUnaryInterceptor(),
+			interceptors.AccessLogUnaryInterceptor(),
+			interceptors.AuthUnaryInterceptor(authenticator),
+			interceptors.RBACUnaryInterceptor(),
+			interceptors.RecoveryUnaryInterceptor(),
+			interceptors.MetricsUnaryInterceptor(),
+		)),
+		grpc.StreamInterceptor(interceptors.ChainStream(
+			interceptors.RequestIDStreamInterceptor(),
+			interceptors.AccessLogStreamInterceptor(),
+			interceptors.AuthStreamInterceptor(authenticator),
+			interceptors.RBACStreamInterceptor(),
+			interceptors.RecoveryStreamInterceptor(),
+			interceptors.MetricsStreamInterceptor(),
+		)),
 	)
 
-	coreServer, err := coreserver.NewServer(llmClient, *dbClients)
-	if err != nil {
-		log.Error(rootCtx, "failed to create coreServer", zap.Error(err))
-	}
+	coreServer := coreserver.NewServer(llmClient, *dbClients, jobPublisher, jobStore, searchService)
 
 	core.RegisterCoreServiceServer(server, coreServer)
 
 	reflection.Register(server)
 
-	log.Info(rootCtx, "Server is listening")
-	if err = server.Serve(lis); err != nil {
-		log.Error(rootCtx, "Failed to launch server", zap.Error(err))
-	}
+	// Health + readiness
+	healthAgg := health.NewAggregator(cfg.HealthCacheTTL,
+		health.Checker{Name: "qdrant", Check: searchService.CheckHealth},
+		health.Checker{Name: "llm", Check: func(ctx context.Context) error {
+			resp, err := llmClient.HealthCheck(ctx, &llm.HealthRequest{})
+			if err != nil {
+				return err
+			}
+			if !resp.Healthy {
+				return fmt.Errorf("llm service reports unhealthy")
+			}
+			return nil
+		}},
+	)
+
+	healthMux := http.NewServeMux()
+	healthMux.HandleFunc("/readyz", healthAgg.ReadyzHandler())
+	healthMux.HandleFunc("/livez", health.LivezHandler())
+	healthMux.Handle("/metrics", promhttp.Handler())
+	healthServer := &http.Server{Addr: fmt.Sprintf(":%s", cfg.HealthPort), Handler: healthMux}
+
+	go func() {
+		if err := healthServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Error(rootCtx, "health server stopped", zap.Error(err))
+		}
+	}()
+
+	go func() {
+		log.Info(rootCtx, "Server is listening")
+		if err := server.Serve(lis); err != nil {
+			log.Error(rootCtx, "Failed to launch server", zap.Error(err))
+		}
+	}()
+
+	// Graceful shutdown: stop accepting traffic, drain gRPC, close the LLM
+	// conn, flush the logger, close the DB pools - in that order.
+	manager := graceful.NewManager(cfg.PreStopDelay, func() { healthAgg.SetReady(false) })
+
+	manager.Register(graceful.Hook{
+		Name: "drain-grpc", Priority: 10, Timeout: cfg.ShutdownTimeout,
+		Fn: func(ctx context.Context) error {
+			done := make(chan struct{})
+			go func() {
+				server.GracefulStop()
+				close(done)
+			}()
+			select {
+			case <-done:
+				return nil
+			case <-ctx.Done():
+				server.Stop()
+				return ctx.Err()
+			}
+		},
+	})
+	manager.Register(graceful.Hook{
+		Name: "stop-health-server", Priority: 15, Timeout: cfg.ShutdownTimeout,
+		Fn: func(ctx context.Context) error { return healthServer.Shutdown(ctx) },
+	})
+	manager.Register(graceful.Hook{
+		Name: "close-llm-conn", Priority: 20, Timeout: cfg.ShutdownTimeout,
+		Fn: func(ctx context.Context) error { return conn.Close() },
+	})
+	manager.Register(graceful.Hook{
+		Name: "flush-logger", Priority: 30, Timeout: cfg.ShutdownTimeout,
+		Fn: func(ctx context.Context) error { return logger.Sync() },
+	})
+	manager.Register(graceful.Hook{
+		Name: "close-redis", Priority: 35, Timeout: cfg.ShutdownTimeout,
+		Fn: func(ctx context.Context) error { return redisClient.Close() },
+	})
+	manager.Register(graceful.Hook{
+		Name: "close-db-pools", Priority: 40, Timeout: cfg.ShutdownTimeout,
+		Fn: func(ctx context.Context) error {
+			pgClient.Close()
+			return qdrantClient.Close()
+		},
+	})
+
+	manager.Wait(rootCtx)
 }
@@ -0,0 +1,168 @@
+// Command runner consumes prompt jobs enqueued by the core API - Qdrant
+// search plus LLM generation - off the client's connection, so a slow LLM
+// call can no longer hold a gRPC stream open, and compute can scale out
+// horizontally by running more of this binary.
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/lazarevFedor/wise-task-ai/server/internal/config"
+	"github.com/lazarevFedor/wise-task-ai/server/internal/embeddings"
+	"github.com/lazarevFedor/wise-task-ai/server/internal/errors"
+	"github.com/lazarevFedor/wise-task-ai/server/internal/jobstore"
+	"github.com/lazarevFedor/wise-task-ai/server/internal/promptjob"
+	"github.com/lazarevFedor/wise-task-ai/server/internal/queue"
+	"github.com/lazarevFedor/wise-task-ai/server/internal/search"
+	"github.com/lazarevFedor/wise-task-ai/server/internal/worker"
+	"github.com/lazarevFedor/wise-task-ai/server/pkg/api/llm-service"
+	"github.com/lazarevFedor/wise-task-ai/server/pkg/db"
+	"github.com/lazarevFedor/wise-task-ai/server/pkg/logger"
+)
+
+func main() {
+	rootCtx := context.Background()
+
+	cfg, err := config.NewRunnerConfig()
+	if err != nil {
+		logger.L().Error(rootCtx, "failed to load runner configuration", zap.Error(err))
+		return
+	}
+
+	if err := logger.Setup(cfg.Log); err != nil {
+		logger.L().Error(rootCtx, "failed to set up logger", zap.Error(err))
+		return
+	}
+	log := logger.L()
+
+	pgPool, err := db.NewPostgres(rootCtx, cfg.Postgres)
+	if err != nil {
+		log.Error(rootCtx, "failed to connect to Postgres", zap.Error(err))
+		return
+	}
+	defer pgPool.Close()
+	jobs := jobstore.NewPostgresJobStore(pgPool)
+
+	redisClient := redis.NewClient(&redis.Options{Addr: cfg.Queue.Addr})
+	defer redisClient.Close()
+	consumer := queue.NewRedisStreamsQueue(redisClient, cfg.Queue)
+
+	qdrantClient, err := db.NewQdrant(rootCtx, cfg.Qdrant)
+	if err != nil {
+		log.Error(rootCtx, "failed to connect to Qdrant", zap.Error(err))
+		return
+	}
+	defer qdrantClient.Close()
+	searchService := search.NewService(qdrantClient, embeddings.NewClient(cfg.Embeddings), nil)
+
+	llmConnURL := fmt.Sprintf("%s:%s", cfg.LLMHost, cfg.LLMPort)
+	conn, err := grpc.NewClient(llmConnURL, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Error(rootCtx, "failed to connect to LLM Service", zap.Error(err))
+		return
+	}
+	defer conn.Close()
+	llmClient := llm.NewLlmServiceClient(conn)
+
+	metricsMux := http.NewServeMux()
+	metricsMux.Handle("/metrics", promhttp.Handler())
+	metricsServer := &http.Server{Addr: fmt.Sprintf(":%s", cfg.HealthPort), Handler: metricsMux}
+
+	go func() {
+		if err := metricsServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Error(rootCtx, "metrics server stopped", zap.Error(err))
+		}
+	}()
+
+	pool := worker.NewPool(cfg.WorkerConfig())
+	runCtx, cancelRun := context.WithCancel(rootCtx)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if err := pool.Run(runCtx, consumer, handlePromptJob(jobs, llmClient, searchService)); err != nil {
+			log.Error(runCtx, "worker pool stopped", zap.Error(err))
+		}
+	}()
+
+	log.Info(rootCtx, "runner is consuming prompt jobs")
+
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
+	sig := <-sigChan
+	log.Info(rootCtx, "runner received shutdown signal", zap.String("signal", sig.String()))
+
+	cancelRun()
+	<-done
+	_ = metricsServer.Shutdown(rootCtx)
+	log.Info(rootCtx, "runner shutdown complete")
+}
+
+// handlePromptJob executes one prompt job: Qdrant search, LLM generation,
+// then persists the outcome so cmd/core can read it back via the job store.
+func handlePromptJob(jobs *jobstore.PostgresJobStore, llmClient llm.LlmServiceClient, searchService *search.Service) queue.Handler {
+	return func(ctx context.Context, job queue.Job) error {
+		log := logger.GetLoggerFromCtx(ctx)
+
+		if job.Kind != queue.KindPrompt {
+			return nil
+		}
+
+		payload, err := promptjob.Unmarshal(job.Payload)
+		if err != nil {
+			log.Error(ctx, "runner: failed to unmarshal prompt job", zap.Error(err), zap.String("job_id", job.ID))
+			return nil
+		}
+
+		if err := jobs.MarkRunning(ctx, job.ID); err != nil {
+			log.Error(ctx, "runner: failed to mark job running", zap.Error(err), zap.String("job_id", job.ID))
+		}
+
+		result, err := runPrompt(ctx, llmClient, searchService, payload)
+		if completeErr := jobs.Complete(ctx, job.ID, result, err); completeErr != nil {
+			log.Error(ctx, "runner: failed to persist job result", zap.Error(completeErr), zap.String("job_id", job.ID))
+		}
+
+		return err
+	}
+}
+
+func runPrompt(ctx context.Context, llmClient llm.LlmServiceClient, searchService *search.Service, payload promptjob.Payload) (string, error) {
+	hits, err := searchService.Search(ctx, payload.Text, search.SearchOptions{})
+	if err != nil {
+		return "", errors.NewCodedError(err, errors.SearchFailedErr)
+	}
+
+	resp, err := llmClient.Generate(ctx, &llm.GenerateRequest{
+		Question:  payload.Text,
+		Contexts:  citations(hits),
+		RequestId: payload.RequestID,
+	})
+	if err != nil {
+		return "", errors.NewCodedError(err, errors.LLMUnavailableErr)
+	}
+
+	return resp.Answer, nil
+}
+
+// citations formats Hits as "source: text" strings so the LLM can cite
+// where an answer came from, instead of the raw point blobs this used to
+// pass through.
+func citations(hits []search.Hit) []string {
+	out := make([]string, len(hits))
+	for i, h := range hits {
+		out[i] = fmt.Sprintf("%s: %s", h.Source, h.Text)
+	}
+	return out
+}
@@ -3,17 +3,31 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/ilyakaznacheev/cleanenv"
+	"github.com/lazarevFedor/wise-task-ai/server/internal/embeddings"
+	"github.com/lazarevFedor/wise-task-ai/server/internal/queue"
+	"github.com/lazarevFedor/wise-task-ai/server/pkg/auth"
 	"github.com/lazarevFedor/wise-task-ai/server/pkg/db"
+	"github.com/lazarevFedor/wise-task-ai/server/pkg/logger"
 )
 
 type CoreServerConfig struct {
-	Qdrant   db.QdrantConfig   `env-prefix:"CORE_QDRANT_"`
-	Postgres db.PostgresConfig `env-prefix:"CORE_POSTGRES_"`
-	Host     string            `env:"CORE_SERVER_HOST"`
-	IntPort  string            `env:"CORE_SERVER_INT_PORT"`
-	RestPort string            `env:"CORE_SERVER_REST_PORT"`
+	Qdrant     db.QdrantConfig          `env-prefix:"CORE_QDRANT_"`
+	Postgres   db.PostgresConfig        `env-prefix:"CORE_POSTGRES_"`
+	Queue      queue.RedisStreamsConfig `env-prefix:"CORE_QUEUE_"`
+	Log        logger.LogConfig         `env-prefix:"CORE_LOG_"`
+	Auth       auth.Config              `env-prefix:"CORE_AUTH_"`
+	Embeddings embeddings.Config        `env-prefix:"CORE_EMBEDDINGS_"`
+	Host       string                   `env:"CORE_SERVER_HOST"`
+	IntPort    string                   `env:"CORE_SERVER_INT_PORT"`
+	RestPort   string                   `env:"CORE_SERVER_REST_PORT"`
+
+	HealthPort      string        `env:"CORE_SERVER_HEALTH_PORT" env-default:"8081"`
+	PreStopDelay    time.Duration `env:"CORE_SERVER_PRESTOP_DELAY" env-default:"5s"`
+	HealthCacheTTL  time.Duration `env:"CORE_SERVER_HEALTH_CACHE_TTL" env-default:"5s"`
+	ShutdownTimeout time.Duration `env:"CORE_SERVER_SHUTDOWN_TIMEOUT" env-default:"10s"`
 }
 
 func NewCoreServerConfig() (*CoreServerConfig, error) {
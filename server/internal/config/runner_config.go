@@ -0,0 +1,50 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/ilyakaznacheev/cleanenv"
+	"github.com/lazarevFedor/wise-task-ai/server/internal/embeddings"
+	"github.com/lazarevFedor/wise-task-ai/server/internal/queue"
+	"github.com/lazarevFedor/wise-task-ai/server/internal/worker"
+	"github.com/lazarevFedor/wise-task-ai/server/pkg/db"
+	"github.com/lazarevFedor/wise-task-ai/server/pkg/logger"
+)
+
+// RunnerConfig configures the cmd/runner binary: the queue it consumes
+// prompt jobs from, the Postgres pool it persists job state to, and the
+// worker pool that drives concurrency/retries.
+type RunnerConfig struct {
+	Queue      queue.RedisStreamsConfig `env-prefix:"RUNNER_QUEUE_"`
+	Postgres   db.PostgresConfig        `env-prefix:"RUNNER_POSTGRES_"`
+	Qdrant     db.QdrantConfig          `env-prefix:"RUNNER_QDRANT_"`
+	Log        logger.LogConfig         `env-prefix:"RUNNER_LOG_"`
+	Embeddings embeddings.Config        `env-prefix:"RUNNER_EMBEDDINGS_"`
+	LLMHost    string                   `env:"RUNNER_LLM_HOST"`
+	LLMPort    string                   `env:"RUNNER_LLM_PORT"`
+
+	WorkerConcurrency int `env:"RUNNER_WORKER_CONCURRENCY" env-default:"4"`
+	WorkerMaxRetries  int `env:"RUNNER_WORKER_MAX_RETRIES" env-default:"3"`
+
+	// HealthPort serves /metrics, so the embeddings client's Prometheus
+	// counters/histograms are scrapeable even though runner has no gRPC
+	// server of its own to hang them off.
+	HealthPort string `env:"RUNNER_HEALTH_PORT" env-default:"8082"`
+}
+
+func NewRunnerConfig() (*RunnerConfig, error) {
+	var cfg RunnerConfig
+	if err := cleanenv.ReadEnv(&cfg); err != nil {
+		return nil, fmt.Errorf("NewRunnerConfig: failed to read env: %w", err)
+	}
+	return &cfg, nil
+}
+
+// WorkerConfig builds a worker.Config from the parts of RunnerConfig that
+// apply to it, keeping worker.DefaultConfig's backoff defaults.
+func (c *RunnerConfig) WorkerConfig() worker.Config {
+	cfg := worker.DefaultConfig()
+	cfg.Concurrency = c.WorkerConcurrency
+	cfg.MaxRetries = c.WorkerMaxRetries
+	return cfg
+}
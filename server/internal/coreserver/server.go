@@ -3,70 +3,166 @@ package coreserver
 
 import (
 	"context"
+	stderrors "errors"
 	"fmt"
-	"github.com/lazarevFedor/wise-task-ai/server/internal/errors"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/lazarevFedor/wise-task-ai/server/internal/errors"
+
 	"go.uber.org/zap"
 
 	"github.com/lazarevFedor/wise-task-ai/server/internal/entities"
-	"github.com/lazarevFedor/wise-task-ai/server/internal/qdrantservice"
+	"github.com/lazarevFedor/wise-task-ai/server/internal/jobstore"
+	"github.com/lazarevFedor/wise-task-ai/server/internal/promptjob"
+	"github.com/lazarevFedor/wise-task-ai/server/internal/queue"
 	"github.com/lazarevFedor/wise-task-ai/server/internal/repository/postgresrepository"
+	"github.com/lazarevFedor/wise-task-ai/server/internal/search"
 	"github.com/lazarevFedor/wise-task-ai/server/pkg/api/core-service"
 	"github.com/lazarevFedor/wise-task-ai/server/pkg/api/llm-service"
 	"github.com/lazarevFedor/wise-task-ai/server/pkg/db"
 	"github.com/lazarevFedor/wise-task-ai/server/pkg/logger"
 )
 
+// resultPollInterval is how often WatchResult re-checks jobstore for a job
+// that hasn't reached a terminal status yet.
+const resultPollInterval = 500 * time.Millisecond
+
+// watchResultTimeout bounds how long WatchResult keeps a stream open for a
+// single job, so a job that never finishes doesn't hold the connection
+// forever.
+const watchResultTimeout = 3 * time.Minute
+
 type Server struct {
 	core.UnimplementedCoreServiceServer
-	llmClient    llm.LlmServiceClient
-	postgresRepo *postgresrepository.PostgresRepository
+	llmClient     llm.LlmServiceClient
+	postgresRepo  *postgresrepository.PostgresRepository
+	jobPublisher  queue.Publisher
+	jobStore      *jobstore.PostgresJobStore
+	searchService *search.Service
 }
 
-func NewServer(client llm.LlmServiceClient, dbCLients db.Clients) *Server {
+func NewServer(client llm.LlmServiceClient, dbCLients db.Clients, jobPublisher queue.Publisher, jobStore *jobstore.PostgresJobStore, searchService *search.Service) *Server {
 	postgresRepo := postgresrepository.New(dbCLients.Postgres)
 
-	return &Server{llmClient: client,
-		postgresRepo: postgresRepo,
+	return &Server{
+		llmClient:     client,
+		postgresRepo:  postgresRepo,
+		jobPublisher:  jobPublisher,
+		jobStore:      jobStore,
+		searchService: searchService,
 	}
 }
 
+// Prompt enqueues the question as a job for cmd/runner and returns its
+// job_id immediately, so a slow LLM call no longer blocks this connection.
+// Callers fetch the outcome via GetResult (poll once) or WatchResult
+// (stream updates until it's done).
 func (s *Server) Prompt(ctx context.Context, req *core.PromptRequest) (*core.PromptResponse, error) {
-	ctx, cancel := context.WithTimeout(ctx, 3*time.Minute)
-	defer cancel()
 	log := logger.GetLoggerFromCtx(ctx)
 
 	resp := &core.PromptResponse{}
 
-	searchResult, err := qdrantservice.Search(req.Text)
+	jobID := uuid.NewString()
+	payload, err := promptjob.Payload{Text: req.Text, RequestID: jobID}.Marshal()
 	if err != nil {
-		dualErr := errors.NewDualError(err, errors.SearchFailedErr)
-		log.Error(ctx, "prompt: failed to search in Qdrant", zap.Error(dualErr.Internal()))
-		return resp, status.Errorf(codes.Unavailable, "%s", dualErr.Public())
+		codedErr := errors.NewCodedError(err, errors.CoreUnavailableErr)
+		log.Error(ctx, "prompt: failed to marshal job payload", zap.Error(err))
+		return resp, errors.ToGRPCStatus(ctx, codedErr).Err()
 	}
 
-	log.Debug(ctx, "Sending Qdrant's response to LLM...:", zap.Strings("requests", searchResult))
-	llmResp, err := s.llmClient.Generate(ctx, &llm.GenerateRequest{
-		Question:  req.Text,
-		Contexts:  searchResult,
-		RequestId: ctx.Value(logger.RequestID).(string),
-	})
-	if err != nil {
-		dualErr := errors.NewDualError(err, errors.LLMUnavailableErr)
-		log.Error(ctx, "prompt: failed to request llmClient.Generate", zap.Error(err))
-		return resp, status.Errorf(codes.Unavailable, dualErr.Public())
+	if err := s.jobStore.Create(ctx, jobID); err != nil {
+		codedErr := errors.NewCodedError(err, errors.CoreUnavailableErr)
+		log.Error(ctx, "prompt: failed to persist job", zap.Error(err))
+		return resp, errors.ToGRPCStatus(ctx, codedErr).Err()
 	}
 
-	resp = &core.PromptResponse{
-		Text:           llmResp.Answer,
-		ProcessingTime: llmResp.ProcessingTime,
+	if err := s.jobPublisher.Publish(ctx, queue.Job{ID: jobID, Kind: queue.KindPrompt, Payload: payload}); err != nil {
+		codedErr := errors.NewCodedError(err, errors.CoreUnavailableErr)
+		log.Error(ctx, "prompt: failed to enqueue job", zap.Error(err), zap.String("job_id", jobID))
+		return resp, errors.ToGRPCStatus(ctx, codedErr).Err()
 	}
+
+	resp.JobId = jobID
 	return resp, nil
 }
 
+// GetResult reports the current state of a previously enqueued prompt job
+// without blocking; Status stays queued/running until the runner finishes.
+// A failed job is surfaced as a gRPC error built from the Code the runner
+// stored, the same as Prompt used to return before it became async.
+func (s *Server) GetResult(ctx context.Context, req *core.GetResultRequest) (*core.GetResultResponse, error) {
+	log := logger.GetLoggerFromCtx(ctx)
+
+	job, err := s.jobStore.Get(ctx, req.JobId)
+	if err != nil {
+		codedErr := jobLookupErr(err)
+		log.Error(ctx, "getresult: failed to read job", zap.Error(err), zap.String("job_id", req.JobId))
+		return nil, errors.ToGRPCStatus(ctx, codedErr).Err()
+	}
+
+	if job.Status == jobstore.StatusFailed {
+		codedErr := errors.NewCodedError(fmt.Errorf("job %s failed: %s", job.ID, job.Error), job.Code)
+		log.Error(ctx, "getresult: job failed", zap.String("job_id", job.ID), zap.String("cause", job.Error))
+		return nil, errors.ToGRPCStatus(ctx, codedErr).Err()
+	}
+
+	return &core.GetResultResponse{Status: string(job.Status), Text: job.Result}, nil
+}
+
+// WatchResult streams the job's status as it changes, polling jobstore
+// every resultPollInterval, until the job succeeds, fails, or
+// watchResultTimeout elapses. A failed job ends the stream with a gRPC
+// error built from the Code the runner stored, rather than a message.
+func (s *Server) WatchResult(req *core.GetResultRequest, stream core.CoreService_WatchResultServer) error {
+	ctx, cancel := context.WithTimeout(stream.Context(), watchResultTimeout)
+	defer cancel()
+	log := logger.GetLoggerFromCtx(ctx)
+
+	ticker := time.NewTicker(resultPollInterval)
+	defer ticker.Stop()
+
+	for {
+		job, err := s.jobStore.Get(ctx, req.JobId)
+		if err != nil {
+			codedErr := jobLookupErr(err)
+			log.Error(ctx, "watchresult: failed to read job", zap.Error(err), zap.String("job_id", req.JobId))
+			return errors.ToGRPCStatus(ctx, codedErr).Err()
+		}
+
+		if job.Status == jobstore.StatusFailed {
+			codedErr := errors.NewCodedError(fmt.Errorf("job %s failed: %s", job.ID, job.Error), job.Code)
+			log.Error(ctx, "watchresult: job failed", zap.String("job_id", job.ID), zap.String("cause", job.Error))
+			return errors.ToGRPCStatus(ctx, codedErr).Err()
+		}
+
+		if err := stream.Send(&core.GetResultResponse{Status: string(job.Status), Text: job.Result}); err != nil {
+			return err
+		}
+
+		if job.Status == jobstore.StatusSucceeded {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			codedErr := errors.NewCodedError(fmt.Errorf("watchresult: job %s: %w", req.JobId, ctx.Err()), errors.LLMTimeoutErr)
+			log.Error(ctx, "watchresult: timed out waiting for job", zap.String("job_id", req.JobId))
+			return errors.ToGRPCStatus(ctx, codedErr).Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// jobLookupErr maps a jobstore.Get failure onto the taxonomy code ToGRPCStatus
+// expects, distinguishing an unknown job_id from a Postgres outage.
+func jobLookupErr(err error) *errors.CodedError {
+	if stderrors.Is(err, jobstore.ErrNotFound) {
+		return errors.NewCodedError(err, errors.NothingFoundErr)
+	}
+	return errors.NewCodedError(err, errors.CoreUnavailableErr)
+}
+
 func (s *Server) Feedback(ctx context.Context, req *core.FeedbackRequest) (*core.FeedbackResponse, error) {
 	log := logger.GetLoggerFromCtx(ctx)
 	log.Info(ctx, "Sending Feedback to DB...:")
@@ -79,9 +175,9 @@ func (s *Server) Feedback(ctx context.Context, req *core.FeedbackRequest) (*core
 
 	resp := &core.FeedbackResponse{}
 	if err := s.postgresRepo.InsertRate(ctx, feedback); err != nil {
-		dualErr := errors.NewDualError(err, errors.PSQLFailedErr)
+		codedErr := errors.NewCodedError(err, errors.PSQLFailedErr)
 		log.Error(ctx, "failed to insert rate to postgres db", zap.Error(err))
-		return resp, status.Errorf(codes.Unavailable, "%s", dualErr.Public())
+		return resp, errors.ToGRPCStatus(ctx, codedErr).Err()
 	}
 
 	return resp, nil
@@ -95,27 +191,27 @@ func (s *Server) HealthCheck(ctx context.Context, req *core.HealthRequest) (*cor
 		Healthy: false,
 	}
 
-	err := qdrantservice.CheckHealth()
+	err := s.searchService.CheckHealth(ctx)
 	if err != nil {
-		dualErr := errors.NewDualError(err, errors.CoreUnavailableErr)
-		log.Error(ctx, "Core_HealthCheck: qdrant unhealth", zap.Error(dualErr.Internal()))
-		return resp, status.Errorf(codes.Unavailable, "%s", dualErr.Public())
+		codedErr := errors.NewCodedError(err, errors.CoreUnavailableErr)
+		log.Error(ctx, "Core_HealthCheck: qdrant unhealth", zap.Error(codedErr.Internal()))
+		return resp, errors.ToGRPCStatus(ctx, codedErr).Err()
 	}
 
 	llmHealthResp, err := s.llmClient.HealthCheck(ctx, &llm.HealthRequest{})
 	if err != nil {
-		dualErr := errors.NewDualError(err, errors.LLMUnavailableErr)
-		log.Error(ctx, "failed to get response from llm service", zap.Error(dualErr.Internal()))
-		return resp, status.Errorf(codes.Unavailable, "%s", dualErr.Public())
+		codedErr := errors.NewCodedError(err, errors.LLMUnavailableErr)
+		log.Error(ctx, "failed to get response from llm service", zap.Error(codedErr.Internal()))
+		return resp, errors.ToGRPCStatus(ctx, codedErr).Err()
 	}
 
 	if !llmHealthResp.Healthy {
-		dualErr := errors.NewDualError(
+		codedErr := errors.NewCodedError(
 			fmt.Errorf("HealthCheck: LLM service is unhealth: %w", err),
 			errors.LLMUnhealthErr,
 		)
-		log.Error(ctx, "HealthCheck: LLM service is unhealth", zap.Error(dualErr.Internal()))
-		return resp, status.Errorf(codes.Unavailable, "%s", dualErr.Public())
+		log.Error(ctx, "HealthCheck: LLM service is unhealth", zap.Error(codedErr.Internal()))
+		return resp, errors.ToGRPCStatus(ctx, codedErr).Err()
 	}
 	resp.Healthy = true
 	return resp, nil
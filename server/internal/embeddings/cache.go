@@ -0,0 +1,101 @@
+package embeddings
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+)
+
+// cache is a content-addressed LRU with a per-entry TTL, so re-embedding an
+// identical chunk is a map lookup instead of a round trip to Ollama.
+type cache struct {
+	mu         sync.Mutex
+	maxEntries int
+	ttl        time.Duration
+	ll         *list.List
+	items      map[string]*list.Element
+
+	hits, misses uint64
+}
+
+type cacheEntry struct {
+	key       string
+	vector    []float32
+	expiresAt time.Time
+}
+
+func newCache(maxEntries int, ttl time.Duration) *cache {
+	return &cache{
+		maxEntries: maxEntries,
+		ttl:        ttl,
+		ll:         list.New(),
+		items:      make(map[string]*list.Element),
+	}
+}
+
+// cacheKey is sha256(model|text), so the same text embedded with a
+// different model isn't served a stale vector.
+func cacheKey(model, text string) string {
+	sum := sha256.Sum256([]byte(model + "|" + text))
+	return hex.EncodeToString(sum[:])
+}
+
+func (c *cache) get(key string) ([]float32, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[key]
+	if !ok {
+		c.misses++
+		return nil, false
+	}
+
+	entry := el.Value.(*cacheEntry)
+	if time.Now().After(entry.expiresAt) {
+		c.ll.Remove(el)
+		delete(c.items, key)
+		c.misses++
+		return nil, false
+	}
+
+	c.ll.MoveToFront(el)
+	c.hits++
+	return entry.vector, true
+}
+
+func (c *cache) set(key string, vector []float32) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[key]; ok {
+		c.ll.MoveToFront(el)
+		entry := el.Value.(*cacheEntry)
+		entry.vector = vector
+		entry.expiresAt = time.Now().Add(c.ttl)
+		return
+	}
+
+	el := c.ll.PushFront(&cacheEntry{key: key, vector: vector, expiresAt: time.Now().Add(c.ttl)})
+	c.items[key] = el
+
+	if c.maxEntries > 0 && c.ll.Len() > c.maxEntries {
+		oldest := c.ll.Back()
+		if oldest != nil {
+			c.ll.Remove(oldest)
+			delete(c.items, oldest.Value.(*cacheEntry).key)
+		}
+	}
+}
+
+func (c *cache) hitRatio() float64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	total := c.hits + c.misses
+	if total == 0 {
+		return 0
+	}
+	return float64(c.hits) / float64(total)
+}
@@ -0,0 +1,175 @@
+package embeddings
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	retryAttempts  = 3
+	retryBaseDelay = 200 * time.Millisecond
+	retryCapDelay  = 2 * time.Second
+)
+
+type ollamaRequest struct {
+	EmbedModel string `json:"model"`
+	Text       string `json:"prompt"`
+}
+
+type ollamaResponse struct {
+	Embedding []float64 `json:"embedding"`
+}
+
+// Client wraps the Ollama embeddings endpoint with batching, caching and
+// retry. The zero value is not usable; build one with NewClient.
+type Client struct {
+	cfg        Config
+	httpClient *http.Client
+	cache      *cache
+}
+
+func NewClient(cfg Config) *Client {
+	return &Client{
+		cfg:        cfg,
+		httpClient: &http.Client{Timeout: cfg.HTTPTimeout},
+		cache:      newCache(cfg.CacheMaxEntries, cfg.CacheTTL),
+	}
+}
+
+// EmbedBatch embeds every text in texts, serving repeats from cache and
+// fanning the rest out across a bounded worker pool, since Ollama's
+// embeddings endpoint only accepts one text per call.
+func (c *Client) EmbedBatch(ctx context.Context, texts []string) ([][]float32, error) {
+	results := make([][]float32, len(texts))
+	errs := make([]error, len(texts))
+
+	sem := make(chan struct{}, c.cfg.Concurrency)
+	var wg sync.WaitGroup
+
+	for i, text := range texts {
+		wg.Add(1)
+		go func(i int, text string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			results[i], errs[i] = c.embedOne(ctx, text)
+		}(i, text)
+	}
+	wg.Wait()
+
+	cacheHitRatio.Set(c.cache.hitRatio())
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return results, nil
+}
+
+func (c *Client) embedOne(ctx context.Context, text string) ([]float32, error) {
+	key := cacheKey(c.cfg.Model, text)
+	if vec, ok := c.cache.get(key); ok {
+		requestsTotal.WithLabelValues("cache_hit").Inc()
+		return vec, nil
+	}
+
+	vec, err := c.embedWithRetry(ctx, text)
+	if err != nil {
+		return nil, err
+	}
+
+	c.cache.set(key, vec)
+	return vec, nil
+}
+
+// embedWithRetry makes up to retryAttempts attempts, sleeping an
+// exponential backoff plus jitter between them, so a brief Ollama hiccup
+// doesn't fail the whole batch.
+func (c *Client) embedWithRetry(ctx context.Context, text string) ([]float32, error) {
+	delay := retryBaseDelay
+	var lastErr error
+
+	for attempt := 0; attempt < retryAttempts; attempt++ {
+		if attempt > 0 {
+			jittered := delay/2 + time.Duration(rand.Int63n(int64(delay/2+1)))
+			select {
+			case <-ctx.Done():
+				return nil, classifyErr(ctx.Err())
+			case <-time.After(jittered):
+			}
+			delay *= 2
+			if delay > retryCapDelay {
+				delay = retryCapDelay
+			}
+		}
+
+		start := time.Now()
+		vec, err := c.embedRequest(ctx, text)
+		requestDuration.Observe(time.Since(start).Seconds())
+		if err == nil {
+			requestsTotal.WithLabelValues("success").Inc()
+			return vec, nil
+		}
+
+		lastErr = err
+		if ctx.Err() != nil {
+			break
+		}
+	}
+
+	requestsTotal.WithLabelValues("failure").Inc()
+	return nil, classifyErr(lastErr)
+}
+
+func (c *Client) embedRequest(ctx context.Context, text string) ([]float32, error) {
+	reqBody, err := json.Marshal(ollamaRequest{EmbedModel: c.cfg.Model, Text: text})
+	if err != nil {
+		return nil, fmt.Errorf("embedRequest: failed to marshal request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.cfg.BaseURL, bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, fmt.Errorf("embedRequest: failed to build request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("embedRequest: failed to request ollama server: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return nil, fmt.Errorf("embedRequest: ollama returned status %d", resp.StatusCode)
+	}
+
+	var result ollamaResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("embedRequest: failed to decode ollama response: %w", err)
+	}
+
+	vec := make([]float32, len(result.Embedding))
+	for i, v := range result.Embedding {
+		vec[i] = float32(v)
+	}
+	return vec, nil
+}
+
+func classifyErr(err error) error {
+	if err == nil {
+		return nil
+	}
+	if stderrors.Is(err, context.DeadlineExceeded) {
+		return fmt.Errorf("%w: %v", ErrEmbedTimeout, err)
+	}
+	return fmt.Errorf("%w: %v", ErrEmbedUnavailable, err)
+}
@@ -0,0 +1,29 @@
+// Package embeddings is a client for the Ollama embeddings endpoint, with
+// batching, a content-addressed cache and retries layered on top since the
+// endpoint itself only accepts one text at a time.
+package embeddings
+
+import "time"
+
+// Config is injectable instead of the package-level ollamaURL/model the
+// client used to hardcode, so tests and other deployments can point it
+// elsewhere.
+type Config struct {
+	BaseURL         string        `env:"BASE_URL" env-default:"http://ollama:11434/api/embeddings"`
+	Model           string        `env:"MODEL" env-default:"nomic-embed-text"`
+	HTTPTimeout     time.Duration `env:"HTTP_TIMEOUT" env-default:"10s"`
+	CacheMaxEntries int           `env:"CACHE_MAX_ENTRIES" env-default:"10000"`
+	CacheTTL        time.Duration `env:"CACHE_TTL" env-default:"24h"`
+	Concurrency     int           `env:"CONCURRENCY" env-default:"8"`
+}
+
+func DefaultConfig() Config {
+	return Config{
+		BaseURL:         "http://ollama:11434/api/embeddings",
+		Model:           "nomic-embed-text",
+		HTTPTimeout:     10 * time.Second,
+		CacheMaxEntries: 10000,
+		CacheTTL:        24 * time.Hour,
+		Concurrency:     8,
+	}
+}
@@ -0,0 +1,11 @@
+package embeddings
+
+import "errors"
+
+// ErrEmbedTimeout and ErrEmbedUnavailable are sentinel causes EmbedBatch
+// wraps its failures in, so callers can classify them (e.g. via
+// errors.Is) before handing them to errors.NewCodedError.
+var (
+	ErrEmbedTimeout     = errors.New("embeddings: request to ollama timed out")
+	ErrEmbedUnavailable = errors.New("embeddings: ollama is unavailable")
+)
@@ -0,0 +1,30 @@
+package embeddings
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	requestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "core",
+		Subsystem: "embeddings",
+		Name:      "requests_total",
+		Help:      "Total embedding requests, labeled by outcome.",
+	}, []string{"outcome"})
+
+	cacheHitRatio = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "core",
+		Subsystem: "embeddings",
+		Name:      "cache_hit_ratio",
+		Help:      "Fraction of EmbedBatch lookups served from the LRU cache.",
+	})
+
+	requestDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "core",
+		Subsystem: "embeddings",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of a single embedding call to Ollama.",
+		Buckets:   prometheus.DefBuckets,
+	})
+)
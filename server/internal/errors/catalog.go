@@ -0,0 +1,56 @@
+package errors
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+)
+
+//go:embed messages/*.json
+var messageFS embed.FS
+
+// defaultLocale is used whenever the caller's locale is empty or has no
+// catalog of its own. The service has historically spoken Russian to
+// end users, so that stays the fallback.
+const defaultLocale = "ru"
+
+var catalogs map[string]map[string]string
+
+func init() {
+	entries, err := messageFS.ReadDir("messages")
+	if err != nil {
+		panic(fmt.Errorf("errors: failed to read message catalogs: %w", err))
+	}
+
+	catalogs = make(map[string]map[string]string, len(entries))
+	for _, entry := range entries {
+		raw, err := messageFS.ReadFile("messages/" + entry.Name())
+		if err != nil {
+			panic(fmt.Errorf("errors: failed to read catalog %s: %w", entry.Name(), err))
+		}
+
+		var catalog map[string]string
+		if err := json.Unmarshal(raw, &catalog); err != nil {
+			panic(fmt.Errorf("errors: failed to parse catalog %s: %w", entry.Name(), err))
+		}
+
+		locale := entry.Name()[:len(entry.Name())-len(".json")]
+		catalogs[locale] = catalog
+	}
+}
+
+// Message resolves the public message for code in the given locale, falling
+// back to defaultLocale when the locale is unknown or has no entry for code.
+func Message(locale string, code Code) string {
+	if catalog, ok := catalogs[locale]; ok {
+		if msg, ok := catalog[code.String()]; ok {
+			return msg
+		}
+	}
+
+	if msg, ok := catalogs[defaultLocale][code.String()]; ok {
+		return msg
+	}
+
+	return "Internal error"
+}
@@ -0,0 +1,56 @@
+// Package errors contains the core service's error taxonomy: scoped/categorized
+// codes, locale-aware public messages and gRPC status mapping.
+package errors
+
+import "fmt"
+
+// Code packs a scope (16 bits), a category (8 bits) and a detail (8 bits)
+// into a single comparable value, e.g. "core service / LLM / timeout".
+type Code uint32
+
+// Category groups codes by the subsystem that raised them, and is what
+// ToGRPCStatus uses to pick a gRPC status code.
+type Category uint8
+
+const (
+	CatSystem Category = iota
+	CatInput
+	CatDB
+	CatAuth
+	CatSearch
+	CatLLM
+)
+
+// ScopeCore identifies errors raised by the core service itself, as opposed
+// to a future scope for errors re-exported from another service.
+const ScopeCore uint16 = 1
+
+// NewCode packs scope/category/detail into a Code.
+func NewCode(scope uint16, category Category, detail uint8) Code {
+	return Code(uint32(scope)<<16 | uint32(category)<<8 | uint32(detail))
+}
+
+func (c Code) Scope() uint16     { return uint16(c >> 16) }
+func (c Code) Category() Category { return Category((c >> 8) & 0xFF) }
+func (c Code) Detail() uint8     { return uint8(c & 0xFF) }
+
+// String renders the code as "scope-category-detail", which also doubles
+// as the key used to look up public messages in the message catalogs.
+func (c Code) String() string {
+	return fmt.Sprintf("%d-%d-%d", c.Scope(), c.Category(), c.Detail())
+}
+
+// Known core-service error codes. Detail numbers are only unique within
+// their category, not globally.
+var (
+	SearchFailedErr    = NewCode(ScopeCore, CatSearch, 1)
+	NothingFoundErr    = NewCode(ScopeCore, CatSearch, 2)
+	LLMTimeoutErr      = NewCode(ScopeCore, CatLLM, 1)
+	LLMUnavailableErr  = NewCode(ScopeCore, CatLLM, 2)
+	LLMUnhealthErr     = NewCode(ScopeCore, CatLLM, 3)
+	PSQLFailedErr      = NewCode(ScopeCore, CatDB, 1)
+	CoreUnavailableErr = NewCode(ScopeCore, CatSystem, 1)
+	UnauthenticatedErr = NewCode(ScopeCore, CatAuth, 1)
+	ForbiddenErr       = NewCode(ScopeCore, CatAuth, 2)
+	InvalidArgumentErr = NewCode(ScopeCore, CatInput, 1)
+)
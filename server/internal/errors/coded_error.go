@@ -0,0 +1,38 @@
+package errors
+
+// CodedError pairs an internal cause with a taxonomy Code. The internal
+// cause is only ever logged; Public resolves a locale-aware message safe to
+// return to clients.
+type CodedError struct {
+	code     Code
+	internal error
+}
+
+// NewCodedError wraps err with code.
+func NewCodedError(err error, code Code) *CodedError {
+	return &CodedError{code: code, internal: err}
+}
+
+func (e *CodedError) Error() string {
+	return e.internal.Error()
+}
+
+// Code returns the taxonomy code, used by ToGRPCStatus to pick a gRPC code.
+func (e *CodedError) Code() Code {
+	return e.code
+}
+
+// Internal returns the wrapped cause, for logging only.
+func (e *CodedError) Internal() error {
+	return e.internal
+}
+
+// Public resolves the client-facing message for locale.
+func (e *CodedError) Public(locale string) string {
+	return Message(locale, e.code)
+}
+
+// Unwrap lets errors.As/Is see through to the internal cause.
+func (e *CodedError) Unwrap() error {
+	return e.internal
+}
@@ -0,0 +1,67 @@
+package errors
+
+import (
+	"context"
+	stderrors "errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// acceptLanguageKey is the gRPC metadata key clients use to request a
+// locale for the public message, mirroring the HTTP Accept-Language header.
+const acceptLanguageKey = "accept-language"
+
+// categoryCodes maps a taxonomy Category to the gRPC status code that best
+// describes it to callers.
+var categoryCodes = map[Category]codes.Code{
+	CatSystem: codes.Unavailable,
+	CatInput:  codes.InvalidArgument,
+	CatDB:     codes.Unavailable,
+	CatAuth:   codes.Unauthenticated,
+	CatSearch: codes.Unavailable,
+	CatLLM:    codes.Unavailable,
+}
+
+// ToGRPCStatus turns err into a *status.Status with a category-appropriate
+// code and a locale-aware public message, reading the requested locale from
+// ctx's incoming "accept-language" metadata. Errors that are not a
+// *CodedError are reported as codes.Internal with no message leaked.
+func ToGRPCStatus(ctx context.Context, err error) *status.Status {
+	var coded *CodedError
+	if !stderrors.As(err, &coded) {
+		return status.New(codes.Internal, "internal error")
+	}
+
+	code := coded.Code()
+	grpcCode, ok := categoryCodes[code.Category()]
+	if !ok {
+		grpcCode = codes.Internal
+	}
+
+	switch code {
+	case LLMTimeoutErr:
+		grpcCode = codes.DeadlineExceeded
+	case NothingFoundErr:
+		grpcCode = codes.NotFound
+	case ForbiddenErr:
+		grpcCode = codes.PermissionDenied
+	}
+
+	return status.New(grpcCode, coded.Public(localeFromContext(ctx)))
+}
+
+func localeFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return defaultLocale
+	}
+
+	values := md.Get(acceptLanguageKey)
+	if len(values) == 0 || values[0] == "" {
+		return defaultLocale
+	}
+
+	return values[0]
+}
@@ -5,53 +5,87 @@ import (
 	"context"
 	"os"
 	"os/signal"
+	"sort"
+	"sync"
 	"syscall"
 	"time"
 
 	"github.com/lazarevFedor/wise-task-ai/server/pkg/logger"
 	"go.uber.org/zap"
-	"google.golang.org/grpc"
 )
 
-
-
+// ShutDownFunc is one step of an orderly shutdown, e.g. draining the gRPC
+// server or closing a DB pool.
 type ShutDownFunc func(ctx context.Context) error
 
-func Wait(ctx context.Context, grpcServer *grpc.Server, extra ...ShutDownFunc){
+// Hook is a named, ordered, individually-timed shutdown step. Hooks run in
+// ascending Priority order; ties run in registration order.
+type Hook struct {
+	Name     string
+	Priority int
+	Timeout  time.Duration
+	Fn       ShutDownFunc
+}
 
-	log := logger.GetLoggerFromCtx(ctx)
+// Manager runs registered Hooks in priority order on SIGINT/SIGTERM, after
+// first giving the caller a chance to stop accepting new traffic and let
+// that propagate to the load balancer.
+type Manager struct {
+	mu           sync.Mutex
+	hooks        []Hook
+	preStopDelay time.Duration
+	beforeDrain  func()
+}
 
-	sigChan := make(chan os.Signal, 1)
-	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
+// NewManager builds a Manager. beforeDrain runs once, right after the
+// shutdown signal arrives and before preStopDelay is slept out - typically
+// flipping a health.Aggregator's readiness off. beforeDrain may be nil.
+func NewManager(preStopDelay time.Duration, beforeDrain func()) *Manager {
+	return &Manager{preStopDelay: preStopDelay, beforeDrain: beforeDrain}
+}
 
-	sig := <- sigChan
-	log.Info(ctx, "Recieved shutdown signal", zap.String("signal", sig.String()))
+// Register adds hook, keeping Manager's hook list sorted by Priority.
+func (m *Manager) Register(hook Hook) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 
-	active := grpcServer.GetServiceInfo()
-	log.Info(ctx, "Active services", zap.Any("services", active))
+	m.hooks = append(m.hooks, hook)
+	sort.SliceStable(m.hooks, func(i, j int) bool {
+		return m.hooks[i].Priority < m.hooks[j].Priority
+	})
+}
 
-	shutdownCtx, cancel := context.WithTimeout(ctx, 5 * time.Second)
-	defer cancel()
+// Wait blocks until SIGINT/SIGTERM, then runs beforeDrain, sleeps
+// preStopDelay, and finally runs every registered hook in order, each with
+// its own timeout, logging (but not stopping on) individual hook failures.
+func (m *Manager) Wait(ctx context.Context) {
+	log := logger.GetLoggerFromCtx(ctx)
 
-	done := make(chan struct{})
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
+	sig := <-sigChan
+	log.Info(ctx, "Received shutdown signal", zap.String("signal", sig.String()))
 
-	go func(){
-		grpcServer.GracefulStop()
-		close(done)
-	}()
+	if m.beforeDrain != nil {
+		m.beforeDrain()
+	}
 
-	select{
-	case <-done:
-		log.Info(ctx, "gRPC server stoped gracefully")
-	case <-shutdownCtx.Done():
-		log.Warn(ctx, "Graceful stop timeout - forcing Stop()")
-		grpcServer.Stop()
+	if m.preStopDelay > 0 {
+		log.Info(ctx, "waiting for readiness change to propagate", zap.Duration("preStopDelay", m.preStopDelay))
+		time.Sleep(m.preStopDelay)
 	}
 
-	for _, fn := range extra{
-		if err := fn(shutdownCtx); err != nil{
-			log.Warn(ctx, "Shutdown step failed", zap.Error(err))
+	m.mu.Lock()
+	hooks := append([]Hook(nil), m.hooks...)
+	m.mu.Unlock()
+
+	for _, hook := range hooks {
+		hookCtx, cancel := context.WithTimeout(ctx, hook.Timeout)
+		if err := hook.Fn(hookCtx); err != nil {
+			log.Warn(ctx, "shutdown hook failed", zap.String("hook", hook.Name), zap.Error(err))
 		}
+		cancel()
 	}
-	log.Info(ctx, "gRPC server shutdown complete")
-}
\ No newline at end of file
+
+	log.Info(ctx, "shutdown complete")
+}
@@ -0,0 +1,100 @@
+package interceptors
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/lazarevFedor/wise-task-ai/server/internal/errors"
+	"github.com/lazarevFedor/wise-task-ai/server/pkg/auth"
+)
+
+type claimsCtxKey struct{}
+
+// ClaimsFromContext returns the JWT claims AuthUnaryInterceptor attached to
+// ctx, if any.
+func ClaimsFromContext(ctx context.Context) (*auth.Claims, bool) {
+	claims, ok := ctx.Value(claimsCtxKey{}).(*auth.Claims)
+	return claims, ok
+}
+
+// AuthUnaryInterceptor validates the "authorization: Bearer <jwt>" metadata
+// on every call whose methodPolicies entry isn't Public, and attaches the
+// resulting Claims to ctx for RBACUnaryInterceptor and the handler.
+func AuthUnaryInterceptor(authenticator *auth.JWTAuthenticator) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if policy, ok := methodPolicies[info.FullMethod]; ok && policy.Public {
+			return handler(ctx, req)
+		}
+
+		token, err := bearerToken(ctx)
+		if err != nil {
+			codedErr := errors.NewCodedError(err, errors.UnauthenticatedErr)
+			return nil, errors.ToGRPCStatus(ctx, codedErr).Err()
+		}
+
+		claims, err := authenticator.Authenticate(ctx, token)
+		if err != nil {
+			codedErr := errors.NewCodedError(err, errors.UnauthenticatedErr)
+			return nil, errors.ToGRPCStatus(ctx, codedErr).Err()
+		}
+
+		return handler(context.WithValue(ctx, claimsCtxKey{}, claims), req)
+	}
+}
+
+// AuthStreamInterceptor is AuthUnaryInterceptor for a server-streaming RPC.
+func AuthStreamInterceptor(authenticator *auth.JWTAuthenticator) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if policy, ok := methodPolicies[info.FullMethod]; ok && policy.Public {
+			return handler(srv, stream)
+		}
+
+		ctx := stream.Context()
+		token, err := bearerToken(ctx)
+		if err != nil {
+			codedErr := errors.NewCodedError(err, errors.UnauthenticatedErr)
+			return errors.ToGRPCStatus(ctx, codedErr).Err()
+		}
+
+		claims, err := authenticator.Authenticate(ctx, token)
+		if err != nil {
+			codedErr := errors.NewCodedError(err, errors.UnauthenticatedErr)
+			return errors.ToGRPCStatus(ctx, codedErr).Err()
+		}
+
+		return handler(srv, withStreamContext(stream, context.WithValue(ctx, claimsCtxKey{}, claims)))
+	}
+}
+
+func bearerToken(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", fmt.Errorf("bearerToken: no metadata in context")
+	}
+
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return "", fmt.Errorf("bearerToken: no authorization header")
+	}
+
+	const prefix = "Bearer "
+	if !strings.HasPrefix(values[0], prefix) {
+		return "", fmt.Errorf("bearerToken: authorization header is not a bearer token")
+	}
+
+	return strings.TrimPrefix(values[0], prefix), nil
+}
@@ -2,51 +2,116 @@
 package interceptors
 
 import (
-    "context"
+	"context"
+	"time"
 
-    "github.com/google/uuid"
-    "github.com/lazarevFedor/wise-task-ai/server/pkg/logger"
-    "go.uber.org/zap"
-    "google.golang.org/grpc"
-    "google.golang.org/grpc/status"
+	"github.com/google/uuid"
+	"github.com/lazarevFedor/wise-task-ai/server/pkg/logger"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
+// RequestIDUnaryInterceptor stamps every request with a request_id, which
+// the rest of the chain (logging, auth, RBAC) and coreserver's handlers
+// pick up via ctx.
+func RequestIDUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		ctx = logger.WithRequestID(ctx, uuid.NewString())
+		return handler(ctx, req)
+	}
+}
 
-func UnaryServerInterceptor(rootCtx context.Context) grpc.UnaryServerInterceptor {
-    rootLogger := logger.GetLoggerFromCtx(rootCtx)
-
-    return func(
-        ctx context.Context,
-        req interface{},
-        info *grpc.UnaryServerInfo,
-        handler grpc.UnaryHandler,
-    ) (resp interface{}, err error) {
-
-        reqID := uuid.NewString()
-        ctx = logger.WithRequestID(ctx, reqID)
-
-        ctx = logger.NewContextWithLogger(ctx, rootLogger)
-		childLogger := logger.GetLoggerFromCtx(ctx)
-        childLogger.Info(ctx, "Incoming gRPC request",
-            zap.String("method", info.FullMethod),
-        )
-
-        resp, err = handler(ctx, req)
-
-        if err != nil {
-            st, _ := status.FromError(err)
-            childLogger.Error(ctx, "gRPC request failed",
-                zap.String("method", info.FullMethod),
-                zap.String("error", st.Message()),
-                zap.Any("code", st.Code()),
-            )
-        } else {
-            childLogger.Info(ctx, "gRPC request completed",
-                zap.String("method", info.FullMethod),
-                zap.Any("response", resp),
-            )
-        }
-
-        return resp, err
-    }
-}
\ No newline at end of file
+// AccessLogUnaryInterceptor emits a single structured access-log entry per
+// call, with latency, code and payload size, rather than separate
+// Info/Error calls scattered across the request lifecycle.
+func AccessLogUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		start := time.Now()
+
+		resp, err := handler(ctx, req)
+
+		st := status.Convert(err)
+		log := logger.GetLoggerFromCtx(ctx)
+		fields := []zap.Field{
+			zap.Duration("latency", time.Since(start)),
+			zap.String("code", st.Code().String()),
+			zap.Int("req_size_bytes", protoSize(req)),
+			zap.Int("resp_size_bytes", protoSize(resp)),
+		}
+
+		if err != nil {
+			log.Error(ctx, "gRPC request", append(fields, zap.String("error", st.Message()))...)
+		} else {
+			log.Info(ctx, "gRPC request", fields...)
+		}
+
+		return resp, err
+	}
+}
+
+// protoSize returns the wire size of v if it is a proto.Message, 0 otherwise.
+func protoSize(v interface{}) int {
+	msg, ok := v.(proto.Message)
+	if !ok || msg == nil {
+		return 0
+	}
+	return proto.Size(msg)
+}
+
+// RequestIDStreamInterceptor is RequestIDUnaryInterceptor for a
+// server-streaming RPC.
+func RequestIDStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		ctx := logger.WithRequestID(stream.Context(), uuid.NewString())
+		return handler(srv, withStreamContext(stream, ctx))
+	}
+}
+
+// AccessLogStreamInterceptor is AccessLogUnaryInterceptor for a
+// server-streaming RPC. It logs once the stream closes, covering its whole
+// lifetime rather than one entry per message sent.
+func AccessLogStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		start := time.Now()
+		ctx := stream.Context()
+
+		err := handler(srv, stream)
+
+		st := status.Convert(err)
+		log := logger.GetLoggerFromCtx(ctx)
+		fields := []zap.Field{
+			zap.Duration("latency", time.Since(start)),
+			zap.String("code", st.Code().String()),
+		}
+
+		if err != nil {
+			log.Error(ctx, "gRPC stream", append(fields, zap.String("error", st.Message()))...)
+		} else {
+			log.Info(ctx, "gRPC stream", fields...)
+		}
+
+		return err
+	}
+}
@@ -0,0 +1,18 @@
+package interceptors
+
+// MethodPolicy describes who may call a gRPC method: either it is Public,
+// or the caller's JWT must carry Role.
+type MethodPolicy struct {
+	Role   string
+	Public bool
+}
+
+// methodPolicies is consulted by AuthUnaryInterceptor (to skip public
+// methods) and RBACUnaryInterceptor (to check the caller's role).
+var methodPolicies = map[string]MethodPolicy{
+	"/core.CoreService/Prompt":      {Role: "user"},
+	"/core.CoreService/GetResult":   {Role: "user"},
+	"/core.CoreService/WatchResult": {Role: "user"},
+	"/core.CoreService/Feedback":    {Role: "user"},
+	"/core.CoreService/HealthCheck": {Public: true},
+}
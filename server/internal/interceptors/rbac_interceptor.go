@@ -0,0 +1,65 @@
+package interceptors
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+
+	"github.com/lazarevFedor/wise-task-ai/server/internal/errors"
+)
+
+// RBACUnaryInterceptor checks the Claims AuthUnaryInterceptor attached to
+// ctx against methodPolicies, rejecting the call if the caller's role
+// doesn't match.
+func RBACUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		policy, ok := methodPolicies[info.FullMethod]
+		if !ok || policy.Public {
+			return handler(ctx, req)
+		}
+
+		claims, ok := ClaimsFromContext(ctx)
+		if !ok || claims.Role != policy.Role {
+			codedErr := errors.NewCodedError(
+				fmt.Errorf("RBAC: method %s requires role %q", info.FullMethod, policy.Role),
+				errors.ForbiddenErr,
+			)
+			return nil, errors.ToGRPCStatus(ctx, codedErr).Err()
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// RBACStreamInterceptor is RBACUnaryInterceptor for a server-streaming RPC.
+func RBACStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		policy, ok := methodPolicies[info.FullMethod]
+		if !ok || policy.Public {
+			return handler(srv, stream)
+		}
+
+		ctx := stream.Context()
+		claims, ok := ClaimsFromContext(ctx)
+		if !ok || claims.Role != policy.Role {
+			codedErr := errors.NewCodedError(
+				fmt.Errorf("RBAC: method %s requires role %q", info.FullMethod, policy.Role),
+				errors.ForbiddenErr,
+			)
+			return errors.ToGRPCStatus(ctx, codedErr).Err()
+		}
+
+		return handler(srv, stream)
+	}
+}
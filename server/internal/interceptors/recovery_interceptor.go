@@ -0,0 +1,61 @@
+package interceptors
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/lazarevFedor/wise-task-ai/server/pkg/logger"
+)
+
+// RecoveryUnaryInterceptor turns a panic in handler into a logged
+// stacktrace and a codes.Internal error, instead of crashing the process.
+func RecoveryUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (resp interface{}, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				logger.GetLoggerFromCtx(ctx).Error(ctx, "panic recovered in gRPC handler",
+					zap.String("method", info.FullMethod),
+					zap.Any("panic", r),
+					zap.Stack("stacktrace"),
+				)
+				err = status.Errorf(codes.Internal, "internal error")
+			}
+		}()
+
+		return handler(ctx, req)
+	}
+}
+
+// RecoveryStreamInterceptor is RecoveryUnaryInterceptor for a
+// server-streaming RPC.
+func RecoveryStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) (err error) {
+		ctx := stream.Context()
+		defer func() {
+			if r := recover(); r != nil {
+				logger.GetLoggerFromCtx(ctx).Error(ctx, "panic recovered in gRPC stream handler",
+					zap.String("method", info.FullMethod),
+					zap.Any("panic", r),
+					zap.Stack("stacktrace"),
+				)
+				err = status.Errorf(codes.Internal, "internal error")
+			}
+		}()
+
+		return handler(srv, stream)
+	}
+}
@@ -0,0 +1,32 @@
+// Package jobstore persists prompt job state so an in-flight job survives
+// a runner crash and the core API can report progress via GetResult.
+package jobstore
+
+import (
+	"time"
+
+	"github.com/lazarevFedor/wise-task-ai/server/internal/errors"
+)
+
+type Status string
+
+const (
+	StatusQueued    Status = "queued"
+	StatusRunning   Status = "running"
+	StatusSucceeded Status = "succeeded"
+	StatusFailed    Status = "failed"
+)
+
+// Job is the persisted state of one prompt job. Code is only meaningful
+// when Status is StatusFailed; it carries the CodedError.Code() of the
+// runner's failure so coreserver can rebuild the same gRPC status instead
+// of collapsing every failure into one generic code.
+type Job struct {
+	ID        string
+	Status    Status
+	Result    string
+	Error     string
+	Code      errors.Code
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
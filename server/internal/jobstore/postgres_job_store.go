@@ -0,0 +1,96 @@
+package jobstore
+
+import (
+	"context"
+	_ "embed"
+	stderrors "errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/lazarevFedor/wise-task-ai/server/internal/errors"
+)
+
+var (
+	//go:embed sql/insert_job.sql
+	insertJobRequest string
+
+	//go:embed sql/update_job_status.sql
+	updateJobStatusRequest string
+
+	//go:embed sql/complete_job.sql
+	completeJobRequest string
+
+	//go:embed sql/get_job.sql
+	getJobRequest string
+)
+
+// ErrNotFound is returned by Get when no job exists with the given ID.
+var ErrNotFound = stderrors.New("jobstore: job not found")
+
+// PostgresJobStore is the Postgres-backed implementation of job persistence,
+// used so a runner crash mid-job doesn't drop the client's prompt.
+type PostgresJobStore struct {
+	pg *pgxpool.Pool
+}
+
+func NewPostgresJobStore(client *pgxpool.Pool) *PostgresJobStore {
+	return &PostgresJobStore{pg: client}
+}
+
+func (s *PostgresJobStore) Create(ctx context.Context, id string) error {
+	if _, err := s.pg.Exec(ctx, insertJobRequest, id, StatusQueued); err != nil {
+		return fmt.Errorf("Create: failed to insert job: %w", err)
+	}
+	return nil
+}
+
+func (s *PostgresJobStore) MarkRunning(ctx context.Context, id string) error {
+	if _, err := s.pg.Exec(ctx, updateJobStatusRequest, id, StatusRunning); err != nil {
+		return fmt.Errorf("MarkRunning: failed to update job: %w", err)
+	}
+	return nil
+}
+
+func (s *PostgresJobStore) Complete(ctx context.Context, id string, result string, jobErr error) error {
+	status := StatusSucceeded
+	errMsg := ""
+	var code errors.Code
+	if jobErr != nil {
+		status = StatusFailed
+		errMsg = jobErr.Error()
+		code = codeOf(jobErr)
+	}
+
+	if _, err := s.pg.Exec(ctx, completeJobRequest, id, status, result, errMsg, code); err != nil {
+		return fmt.Errorf("Complete: failed to update job: %w", err)
+	}
+	return nil
+}
+
+func (s *PostgresJobStore) Get(ctx context.Context, id string) (*Job, error) {
+	row := s.pg.QueryRow(ctx, getJobRequest, id)
+
+	job := &Job{}
+	err := row.Scan(&job.ID, &job.Status, &job.Result, &job.Error, &job.Code, &job.CreatedAt, &job.UpdatedAt)
+	if stderrors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Get: failed to scan job: %w", err)
+	}
+
+	return job, nil
+}
+
+// codeOf extracts the CodedError taxonomy code from jobErr so it survives
+// the trip through Postgres, falling back to CoreUnavailableErr for a
+// plain error the runner didn't wrap (a bug there, not a known failure mode).
+func codeOf(jobErr error) errors.Code {
+	var coded *errors.CodedError
+	if stderrors.As(jobErr, &coded) {
+		return coded.Code()
+	}
+	return errors.CoreUnavailableErr
+}
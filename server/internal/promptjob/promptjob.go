@@ -0,0 +1,31 @@
+// Package promptjob defines the job payload shared by coreserver (which
+// enqueues it) and cmd/runner (which executes it), so both sides agree on
+// its wire shape without importing each other.
+package promptjob
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Payload is the JSON body of a queue.Job with Kind == queue.KindPrompt.
+type Payload struct {
+	Text      string `json:"text"`
+	RequestID string `json:"request_id"`
+}
+
+func (p Payload) Marshal() ([]byte, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, fmt.Errorf("Payload.Marshal: %w", err)
+	}
+	return data, nil
+}
+
+func Unmarshal(data []byte) (Payload, error) {
+	var p Payload
+	if err := json.Unmarshal(data, &p); err != nil {
+		return Payload{}, fmt.Errorf("promptjob.Unmarshal: %w", err)
+	}
+	return p, nil
+}
@@ -0,0 +1,35 @@
+// Package queue decouples job producers (the core API) from job consumers
+// (the runner) behind a small Publisher/Consumer interface, so the broker
+// underneath (NATS JetStream, Redis Streams, ...) can be swapped freely.
+package queue
+
+import "context"
+
+// Kind identifies what a Job's Payload contains and how the runner should
+// handle it.
+type Kind string
+
+const (
+	KindPrompt Kind = "prompt"
+)
+
+// Job is the unit of work handed from the core API to the runner.
+type Job struct {
+	ID      string
+	Kind    Kind
+	Payload []byte
+}
+
+// Handler processes a single Job. Returning an error causes the Consumer to
+// retry delivery according to the broker's redelivery policy.
+type Handler func(ctx context.Context, job Job) error
+
+// Publisher enqueues jobs for later processing.
+type Publisher interface {
+	Publish(ctx context.Context, job Job) error
+}
+
+// Consumer drains jobs and feeds them to handler until ctx is cancelled.
+type Consumer interface {
+	Consume(ctx context.Context, handler Handler) error
+}
@@ -0,0 +1,159 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisStreamsConfig configures a RedisStreamsQueue.
+type RedisStreamsConfig struct {
+	Addr     string `env:"ADDR"`
+	Stream   string `env:"STREAM" env-default:"core.jobs"`
+	Group    string `env:"GROUP" env-default:"runner"`
+	Consumer string `env:"CONSUMER" env-default:"runner-1"`
+
+	// ReclaimInterval is how often Consume sweeps the consumer group's
+	// pending entries list for messages an earlier, now-dead consumer
+	// never acked, so a crashed runner doesn't strand a job forever.
+	ReclaimInterval time.Duration `env:"RECLAIM_INTERVAL" env-default:"15s"`
+	// ReclaimMinIdle is how long a pending entry must sit unacked before
+	// it's considered abandoned and claimed by this consumer.
+	ReclaimMinIdle time.Duration `env:"RECLAIM_MIN_IDLE" env-default:"30s"`
+}
+
+// RedisStreamsQueue implements Publisher and Consumer on top of a Redis
+// Stream with a consumer group, giving the runner at-least-once delivery
+// and the ability to scale out by running several consumers in the group.
+type RedisStreamsQueue struct {
+	client *redis.Client
+	cfg    RedisStreamsConfig
+
+	// reclaimStart ensures reclaimLoop starts once per queue, not once per
+	// Consume call - worker.Pool runs several of those concurrently against
+	// the same queue, and they'd otherwise all hammer XAutoClaim on the
+	// same schedule.
+	reclaimStart sync.Once
+}
+
+func NewRedisStreamsQueue(client *redis.Client, cfg RedisStreamsConfig) *RedisStreamsQueue {
+	return &RedisStreamsQueue{client: client, cfg: cfg}
+}
+
+func (q *RedisStreamsQueue) Publish(ctx context.Context, job Job) error {
+	_, err := q.client.XAdd(ctx, &redis.XAddArgs{
+		Stream: q.cfg.Stream,
+		Values: map[string]interface{}{
+			"id":      job.ID,
+			"kind":    string(job.Kind),
+			"payload": job.Payload,
+		},
+	}).Result()
+	if err != nil {
+		return fmt.Errorf("RedisStreamsQueue.Publish: failed to XAdd: %w", err)
+	}
+	return nil
+}
+
+func (q *RedisStreamsQueue) Consume(ctx context.Context, handler Handler) error {
+	if err := q.client.XGroupCreateMkStream(ctx, q.cfg.Stream, q.cfg.Group, "$").Err(); err != nil &&
+		!isBusyGroupErr(err) {
+		return fmt.Errorf("RedisStreamsQueue.Consume: failed to create group: %w", err)
+	}
+
+	q.reclaimStart.Do(func() { go q.reclaimLoop(ctx, handler) })
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		streams, err := q.client.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    q.cfg.Group,
+			Consumer: q.cfg.Consumer,
+			Streams:  []string{q.cfg.Stream, ">"},
+			Count:    10,
+			Block:    0,
+		}).Result()
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return fmt.Errorf("RedisStreamsQueue.Consume: failed to XReadGroup: %w", err)
+		}
+
+		for _, stream := range streams {
+			q.handleMessages(ctx, handler, stream.Messages)
+		}
+	}
+}
+
+// reclaimLoop periodically claims pending entries idle longer than
+// ReclaimMinIdle, so a job a crashed consumer never acked gets redelivered
+// instead of staying stuck at jobstore.StatusRunning forever.
+func (q *RedisStreamsQueue) reclaimLoop(ctx context.Context, handler Handler) {
+	ticker := time.NewTicker(q.cfg.ReclaimInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			q.reclaimOnce(ctx, handler)
+		}
+	}
+}
+
+func (q *RedisStreamsQueue) reclaimOnce(ctx context.Context, handler Handler) {
+	start := "0-0"
+	for {
+		messages, next, err := q.client.XAutoClaim(ctx, &redis.XAutoClaimArgs{
+			Stream:   q.cfg.Stream,
+			Group:    q.cfg.Group,
+			Consumer: q.cfg.Consumer,
+			MinIdle:  q.cfg.ReclaimMinIdle,
+			Start:    start,
+			Count:    10,
+		}).Result()
+		if err != nil || len(messages) == 0 {
+			return
+		}
+
+		q.handleMessages(ctx, handler, messages)
+
+		start = next
+		if start == "0-0" {
+			return
+		}
+	}
+}
+
+func (q *RedisStreamsQueue) handleMessages(ctx context.Context, handler Handler, messages []redis.XMessage) {
+	for _, msg := range messages {
+		job, err := jobFromMessage(msg)
+		if err != nil {
+			continue
+		}
+
+		if herr := handler(ctx, job); herr == nil {
+			q.client.XAck(ctx, q.cfg.Stream, q.cfg.Group, msg.ID)
+		}
+	}
+}
+
+func jobFromMessage(msg redis.XMessage) (Job, error) {
+	id, _ := msg.Values["id"].(string)
+	kind, _ := msg.Values["kind"].(string)
+	payload, _ := msg.Values["payload"].(string)
+	return Job{ID: id, Kind: Kind(kind), Payload: []byte(payload)}, nil
+}
+
+func isBusyGroupErr(err error) bool {
+	return err != nil && err.Error() == "BUSYGROUP Consumer Group name already exists"
+}
@@ -0,0 +1,105 @@
+package search
+
+import (
+	"math"
+	"strings"
+	"unicode"
+)
+
+// BM25 constants, standard values (Robertson & Zaragoza's defaults).
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+// bm25Scores scores each candidate's Text against query with BM25. Corpus
+// statistics (document frequency, average length) are computed over the
+// candidate set itself rather than the full collection, since Qdrant
+// already did the job of narrowing it down - this is enough to break ties
+// and catch exact keyword matches the embedding missed, not a replacement
+// for a real full-text index.
+func bm25Scores(query string, candidates []Hit) []float32 {
+	queryTerms := tokenize(query)
+	docs := make([][]string, len(candidates))
+	var totalLen float64
+	for i, c := range candidates {
+		docs[i] = tokenize(c.Text)
+		totalLen += float64(len(docs[i]))
+	}
+	if len(docs) == 0 {
+		return nil
+	}
+	avgLen := totalLen / float64(len(docs))
+
+	df := make(map[string]int, len(queryTerms))
+	for _, term := range dedup(queryTerms) {
+		for _, doc := range docs {
+			if contains(doc, term) {
+				df[term]++
+			}
+		}
+	}
+
+	scores := make([]float32, len(docs))
+	for i, doc := range docs {
+		scores[i] = float32(bm25Score(queryTerms, doc, df, len(docs), avgLen))
+	}
+	return scores
+}
+
+func bm25Score(queryTerms, doc []string, df map[string]int, docCount int, avgLen float64) float64 {
+	if avgLen == 0 {
+		return 0
+	}
+	tf := termFrequency(doc)
+	docLen := float64(len(doc))
+
+	var score float64
+	for _, term := range queryTerms {
+		freq, ok := tf[term]
+		if !ok {
+			continue
+		}
+		idf := math.Log(1 + (float64(docCount)-float64(df[term])+0.5)/(float64(df[term])+0.5))
+		numerator := float64(freq) * (bm25K1 + 1)
+		denominator := float64(freq) + bm25K1*(1-bm25B+bm25B*docLen/avgLen)
+		score += idf * numerator / denominator
+	}
+	return score
+}
+
+func termFrequency(doc []string) map[string]int {
+	tf := make(map[string]int, len(doc))
+	for _, term := range doc {
+		tf[term]++
+	}
+	return tf
+}
+
+func contains(doc []string, term string) bool {
+	for _, t := range doc {
+		if t == term {
+			return true
+		}
+	}
+	return false
+}
+
+func dedup(terms []string) []string {
+	seen := make(map[string]struct{}, len(terms))
+	out := make([]string, 0, len(terms))
+	for _, t := range terms {
+		if _, ok := seen[t]; ok {
+			continue
+		}
+		seen[t] = struct{}{}
+		out = append(out, t)
+	}
+	return out
+}
+
+func tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
@@ -0,0 +1,215 @@
+// Package search is the core service's retrieval backend: it embeds a
+// query, runs a dense similarity search in Qdrant, blends the result with
+// BM25-style keyword scoring over the retrieved text, and returns
+// structured Hits an LLM prompt can cite. It replaces the old qdrantservice
+// (HTTP to an external ingest proxy) and the unused native qdrantrepository.
+package search
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/qdrant/go-client/qdrant"
+
+	"github.com/lazarevFedor/wise-task-ai/server/internal/embeddings"
+	"github.com/lazarevFedor/wise-task-ai/server/internal/errors"
+)
+
+const (
+	defaultCollection = "latex_books"
+	defaultLimit      = 5
+	defaultAlpha      = 0.5
+
+	// oversampleFactor widens the Qdrant candidate set beyond opts.Limit so
+	// the keyword pass and reranker have something to actually re-order.
+	oversampleFactor = 4
+)
+
+// Hit is one retrieved chunk, ready to be cited in an LLM prompt instead of
+// the raw point.String() blobs qdrantrepository used to return.
+type Hit struct {
+	Score      float32
+	Title      string
+	Source     string
+	ChunkIndex int
+	Text       string
+}
+
+// SearchOptions tunes a single Search call. Zero values fall back to sane
+// defaults (see Search), except HybridAlpha: its zero value (pure keyword
+// search) is a legitimate setting, so nil rather than 0 means "unset".
+type SearchOptions struct {
+	Limit          int
+	ScoreThreshold float32
+	Collection     string
+	Filter         map[string]string
+	HybridAlpha    *float32
+}
+
+// Reranker reorders or drops hybrid-scored candidates before Search
+// truncates to opts.Limit. It's the hook a future cross-encoder pass plugs
+// into; NoopReranker is used when none is configured.
+type Reranker interface {
+	Rerank(ctx context.Context, query string, hits []Hit) ([]Hit, error)
+}
+
+// NoopReranker leaves hits in hybrid-score order.
+type NoopReranker struct{}
+
+func (NoopReranker) Rerank(_ context.Context, _ string, hits []Hit) ([]Hit, error) {
+	return hits, nil
+}
+
+// Service is internal/search's entry point.
+type Service struct {
+	qdrant   *qdrant.Client
+	embedder *embeddings.Client
+	reranker Reranker
+}
+
+// NewService wires a Service. reranker may be nil, in which case hits keep
+// hybrid-score order.
+func NewService(qdrantClient *qdrant.Client, embedder *embeddings.Client, reranker Reranker) *Service {
+	if reranker == nil {
+		reranker = NoopReranker{}
+	}
+	return &Service{qdrant: qdrantClient, embedder: embedder, reranker: reranker}
+}
+
+// Search embeds query, pulls an oversampled candidate set from Qdrant,
+// blends dense similarity with keyword scoring over each candidate's text
+// payload (final = alpha*dense + (1-alpha)*sparse, both normalized to
+// [0,1]), reranks, and returns the top opts.Limit Hits.
+func (s *Service) Search(ctx context.Context, query string, opts SearchOptions) ([]Hit, error) {
+	collection := opts.Collection
+	if collection == "" {
+		collection = defaultCollection
+	}
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	alpha := float32(defaultAlpha)
+	if opts.HybridAlpha != nil {
+		alpha = *opts.HybridAlpha
+	}
+
+	vectors, err := s.embedder.EmbedBatch(ctx, []string{query})
+	if err != nil {
+		return nil, errors.NewCodedError(fmt.Errorf("Search: failed to embed query: %w", err), errors.SearchFailedErr)
+	}
+
+	points, err := s.qdrant.Query(ctx, &qdrant.QueryPoints{
+		CollectionName: collection,
+		Query:          qdrant.NewQuery(vectors[0]...),
+		Limit:          qdrant.PtrOf(uint64(limit * oversampleFactor)),
+		Filter:         buildFilter(opts.Filter),
+		WithPayload:    qdrant.NewWithPayload(true),
+	})
+	if err != nil {
+		return nil, errors.NewCodedError(fmt.Errorf("Search: failed to query Qdrant: %w", err), errors.SearchFailedErr)
+	}
+	if len(points) == 0 {
+		return nil, errors.NewCodedError(fmt.Errorf("Search: no points in collection %q", collection), errors.NothingFoundErr)
+	}
+
+	candidates := make([]Hit, len(points))
+	denseScores := make([]float32, len(points))
+	for i, p := range points {
+		candidates[i] = hitFromPoint(p)
+		denseScores[i] = p.GetScore()
+	}
+
+	dense := normalize(denseScores)
+	sparse := normalize(bm25Scores(query, candidates))
+	for i := range candidates {
+		candidates[i].Score = alpha*dense[i] + (1-alpha)*sparse[i]
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].Score > candidates[j].Score })
+
+	if opts.ScoreThreshold > 0 {
+		candidates = filterByThreshold(candidates, opts.ScoreThreshold)
+	}
+
+	hits, err := s.reranker.Rerank(ctx, query, candidates)
+	if err != nil {
+		return nil, errors.NewCodedError(fmt.Errorf("Search: reranker failed: %w", err), errors.SearchFailedErr)
+	}
+
+	if len(hits) > limit {
+		hits = hits[:limit]
+	}
+	return hits, nil
+}
+
+// CheckHealth reports whether the Qdrant cluster is reachable, for
+// pkg/health checkers and coreserver.HealthCheck.
+func (s *Service) CheckHealth(ctx context.Context) error {
+	if _, err := s.qdrant.HealthCheck(ctx); err != nil {
+		return fmt.Errorf("CheckHealth: Qdrant is unhealthy: %w", err)
+	}
+	return nil
+}
+
+func hitFromPoint(p *qdrant.ScoredPoint) Hit {
+	payload := p.GetPayload()
+	return Hit{
+		Title:      payload["title"].GetStringValue(),
+		Source:     payload["source"].GetStringValue(),
+		ChunkIndex: int(payload["chunk_index"].GetIntegerValue()),
+		Text:       payload["text"].GetStringValue(),
+	}
+}
+
+func buildFilter(filter map[string]string) *qdrant.Filter {
+	if len(filter) == 0 {
+		return nil
+	}
+	conditions := make([]*qdrant.Condition, 0, len(filter))
+	for key, value := range filter {
+		conditions = append(conditions, qdrant.NewMatch(key, value))
+	}
+	return &qdrant.Filter{Must: conditions}
+}
+
+// filterByThreshold drops hits scoring below threshold, in place.
+func filterByThreshold(hits []Hit, threshold float32) []Hit {
+	kept := hits[:0]
+	for _, h := range hits {
+		if h.Score >= threshold {
+			kept = append(kept, h)
+		}
+	}
+	return kept
+}
+
+// normalize min-max scales scores into [0,1]. A flat input (all scores
+// equal) normalizes to all 1s rather than dividing by zero.
+func normalize(scores []float32) []float32 {
+	normalized := make([]float32, len(scores))
+	if len(scores) == 0 {
+		return normalized
+	}
+
+	min, max := scores[0], scores[0]
+	for _, v := range scores[1:] {
+		if v < min {
+			min = v
+		}
+		if v > max {
+			max = v
+		}
+	}
+	if max == min {
+		for i := range normalized {
+			normalized[i] = 1
+		}
+		return normalized
+	}
+	for i, v := range scores {
+		normalized[i] = (v - min) / (max - min)
+	}
+	return normalized
+}
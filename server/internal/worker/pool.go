@@ -0,0 +1,107 @@
+// Package worker runs queue.Handler functions against a queue.Consumer with
+// a bounded pool of goroutines and retries on transient LLM errors.
+package worker
+
+import (
+	"context"
+	stderrors "errors"
+	"sync"
+	"time"
+
+	"github.com/lazarevFedor/wise-task-ai/server/internal/errors"
+	"github.com/lazarevFedor/wise-task-ai/server/internal/queue"
+)
+
+// Config tunes the pool's concurrency and retry-with-backoff behaviour.
+type Config struct {
+	Concurrency int
+	MaxRetries  int
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+}
+
+// DefaultConfig matches the repo's existing "small, pragmatic" defaults.
+func DefaultConfig() Config {
+	return Config{
+		Concurrency: 4,
+		MaxRetries:  3,
+		BaseBackoff: 200 * time.Millisecond,
+		MaxBackoff:  2 * time.Second,
+	}
+}
+
+type Pool struct {
+	cfg Config
+}
+
+func NewPool(cfg Config) *Pool {
+	return &Pool{cfg: cfg}
+}
+
+// Run starts cfg.Concurrency goroutines, each consuming from consumer and
+// running process with retry-with-backoff, until ctx is cancelled or one of
+// the consumers returns a non-context error.
+func (p *Pool) Run(ctx context.Context, consumer queue.Consumer, process queue.Handler) error {
+	handler := p.withRetry(process)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	errCh := make(chan error, p.cfg.Concurrency)
+
+	for i := 0; i < p.cfg.Concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := consumer.Consume(ctx, handler); err != nil && ctx.Err() == nil {
+				errCh <- err
+				cancel()
+			}
+		}()
+	}
+
+	wg.Wait()
+	close(errCh)
+
+	for err := range errCh {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *Pool) withRetry(process queue.Handler) queue.Handler {
+	return func(ctx context.Context, job queue.Job) error {
+		backoff := p.cfg.BaseBackoff
+
+		var err error
+		for attempt := 0; attempt <= p.cfg.MaxRetries; attempt++ {
+			err = process(ctx, job)
+			if err == nil || !isRetryable(err) {
+				return err
+			}
+
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(backoff):
+			}
+
+			backoff *= 2
+			if backoff > p.cfg.MaxBackoff {
+				backoff = p.cfg.MaxBackoff
+			}
+		}
+		return err
+	}
+}
+
+func isRetryable(err error) bool {
+	var coded *errors.CodedError
+	if !stderrors.As(err, &coded) {
+		return false
+	}
+	return coded.Code() == errors.LLMTimeoutErr || coded.Code() == errors.LLMUnavailableErr
+}
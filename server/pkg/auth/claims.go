@@ -0,0 +1,11 @@
+package auth
+
+import "github.com/golang-jwt/jwt/v5"
+
+// Claims is the JWT payload the core service expects: the standard
+// registered claims plus the role the RBAC interceptor checks against a
+// MethodPolicy.
+type Claims struct {
+	jwt.RegisteredClaims
+	Role string `json:"role"`
+}
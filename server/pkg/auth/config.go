@@ -0,0 +1,25 @@
+// Package auth authenticates gRPC calls via JWT (HS256 or RS256, with JWKS
+// refresh for the latter) and carries the role claim that the
+// interceptors' per-method RBAC checks.
+package auth
+
+import "time"
+
+// Algorithm selects how JWTAuthenticator verifies a token's signature.
+type Algorithm string
+
+const (
+	AlgHS256 Algorithm = "HS256"
+	AlgRS256 Algorithm = "RS256"
+)
+
+// Config configures a JWTAuthenticator.
+type Config struct {
+	Algorithm   Algorithm     `env:"ALGORITHM" env-default:"HS256"`
+	HMACSecret  string        `env:"HMAC_SECRET"`
+	JWKSURL     string        `env:"JWKS_URL"`
+	JWKSRefresh time.Duration `env:"JWKS_REFRESH" env-default:"1h"`
+	Leeway      time.Duration `env:"LEEWAY" env-default:"30s"`
+	Issuer      string        `env:"ISSUER"`
+	Audience    string        `env:"AUDIENCE"`
+}
@@ -0,0 +1,54 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// JWTAuthenticator validates bearer tokens presented to the gRPC API,
+// either against a static HMAC secret or an RS256 key fetched from JWKS.
+type JWTAuthenticator struct {
+	cfg  Config
+	jwks *jwksCache
+}
+
+func NewJWTAuthenticator(cfg Config) *JWTAuthenticator {
+	a := &JWTAuthenticator{cfg: cfg}
+	if cfg.Algorithm == AlgRS256 {
+		a.jwks = newJWKSCache(cfg.JWKSURL, cfg.JWKSRefresh)
+	}
+	return a
+}
+
+// Authenticate parses and validates tokenString's signature, issuer,
+// audience and expiry (with cfg.Leeway), returning its Claims.
+func (a *JWTAuthenticator) Authenticate(_ context.Context, tokenString string) (*Claims, error) {
+	parser := jwt.NewParser(
+		jwt.WithLeeway(a.cfg.Leeway),
+		jwt.WithIssuer(a.cfg.Issuer),
+		jwt.WithAudience(a.cfg.Audience),
+		jwt.WithValidMethods([]string{string(a.cfg.Algorithm)}),
+	)
+
+	claims := &Claims{}
+	if _, err := parser.ParseWithClaims(tokenString, claims, a.keyFunc); err != nil {
+		return nil, fmt.Errorf("Authenticate: invalid token: %w", err)
+	}
+
+	return claims, nil
+}
+
+func (a *JWTAuthenticator) keyFunc(token *jwt.Token) (interface{}, error) {
+	if a.cfg.Algorithm == AlgRS256 {
+		kid, _ := token.Header["kid"].(string)
+		key, ok := a.jwks.key(kid)
+		if !ok {
+			return nil, fmt.Errorf("keyFunc: unknown kid %q", kid)
+		}
+		return key, nil
+	}
+
+	return []byte(a.cfg.HMACSecret), nil
+}
@@ -0,0 +1,127 @@
+// Package health aggregates upstream health checks behind cached /readyz
+// and /livez HTTP handlers, so a load balancer probing every few seconds
+// doesn't turn into a denial-of-service against Qdrant or the LLM service.
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// CheckFunc reports whether one upstream is healthy.
+type CheckFunc func(ctx context.Context) error
+
+// Checker names a single upstream dependency.
+type Checker struct {
+	Name  string
+	Check CheckFunc
+}
+
+type cachedResult struct {
+	err       error
+	checkedAt time.Time
+}
+
+// Aggregator answers /readyz from a cached result per Checker, refreshing
+// each one at most once per cacheTTL (circuit-breaker style), plus a
+// process-wide ready flag the caller flips off during shutdown.
+type Aggregator struct {
+	checkers []Checker
+	cacheTTL time.Duration
+
+	mu     sync.Mutex
+	cached map[string]cachedResult
+
+	readyMu sync.RWMutex
+	ready   bool
+}
+
+func NewAggregator(cacheTTL time.Duration, checkers ...Checker) *Aggregator {
+	return &Aggregator{
+		checkers: checkers,
+		cacheTTL: cacheTTL,
+		cached:   make(map[string]cachedResult),
+		ready:    true,
+	}
+}
+
+// SetReady flips the readiness flag /readyz reports, independently of the
+// upstream checks. The graceful.Manager flips it false at the start of
+// shutdown, before draining traffic.
+func (a *Aggregator) SetReady(ready bool) {
+	a.readyMu.Lock()
+	a.ready = ready
+	a.readyMu.Unlock()
+}
+
+func (a *Aggregator) Ready() bool {
+	a.readyMu.RLock()
+	defer a.readyMu.RUnlock()
+	return a.ready
+}
+
+// Check runs (or serves cached) results for every registered Checker.
+func (a *Aggregator) Check(ctx context.Context) map[string]error {
+	results := make(map[string]error, len(a.checkers))
+	for _, c := range a.checkers {
+		results[c.Name] = a.checkOne(ctx, c)
+	}
+	return results
+}
+
+func (a *Aggregator) checkOne(ctx context.Context, c Checker) error {
+	a.mu.Lock()
+	cached, ok := a.cached[c.Name]
+	a.mu.Unlock()
+	if ok && time.Since(cached.checkedAt) < a.cacheTTL {
+		return cached.err
+	}
+
+	err := c.Check(ctx)
+
+	a.mu.Lock()
+	a.cached[c.Name] = cachedResult{err: err, checkedAt: time.Now()}
+	a.mu.Unlock()
+
+	return err
+}
+
+// ReadyzHandler reports 200 only once SetReady(true) and every Checker is
+// currently passing; otherwise 503, so k8s stops routing new traffic here.
+func (a *Aggregator) ReadyzHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !a.Ready() {
+			writeJSON(w, http.StatusServiceUnavailable, map[string]string{"status": "not ready"})
+			return
+		}
+
+		for name, err := range a.Check(r.Context()) {
+			if err != nil {
+				writeJSON(w, http.StatusServiceUnavailable, map[string]string{
+					"status":       "unhealthy",
+					"failed_check": name,
+				})
+				return
+			}
+		}
+
+		writeJSON(w, http.StatusOK, map[string]string{"status": "ready"})
+	}
+}
+
+// LivezHandler reports the process is up. It never touches upstreams, so a
+// stuck dependency can't get a healthy process killed by the liveness probe.
+func LivezHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]string{"status": "alive"})
+	}
+}
+
+func writeJSON(w http.ResponseWriter, code int, body map[string]string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,35 @@
+package logger
+
+// Encoding selects the zap encoder used for log output.
+type Encoding string
+
+const (
+	EncodingJSON    Encoding = "json"
+	EncodingConsole Encoding = "console"
+)
+
+// SamplingConfig mirrors zap's sampling knobs: after the first Initial
+// entries with the same level+message in a one-second window, only every
+// Thereafter-th one is logged.
+type SamplingConfig struct {
+	Initial    int `env:"INITIAL" env-default:"100"`
+	Thereafter int `env:"THEREAFTER" env-default:"100"`
+}
+
+// RotationConfig configures lumberjack-style log file rotation. It is
+// ignored for the "stdout"/"stderr" output paths.
+type RotationConfig struct {
+	MaxSizeMB  int `env:"MAX_SIZE_MB" env-default:"100"`
+	MaxAgeDays int `env:"MAX_AGE_DAYS" env-default:"28"`
+	MaxBackups int `env:"MAX_BACKUPS" env-default:"3"`
+}
+
+// LogConfig is consumed by Setup to build the global logger.
+type LogConfig struct {
+	Level           string         `env:"LEVEL" env-default:"info"`
+	Encoding        Encoding       `env:"ENCODING" env-default:"console"`
+	OutputPaths     []string       `env:"OUTPUT_PATHS" env-default:"stdout" env-separator:","`
+	Sampling        SamplingConfig `env-prefix:"SAMPLING_"`
+	Rotation        RotationConfig `env-prefix:"ROTATION_"`
+	StacktraceLevel string         `env:"STACKTRACE_LEVEL" env-default:"error"`
+}
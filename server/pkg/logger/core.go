@@ -0,0 +1,77 @@
+package logger
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// samplingTick is the window over which Sampling.Initial/Thereafter apply.
+const samplingTick = time.Second
+
+func buildCore(cfg LogConfig) (zapcore.Core, error) {
+	var level zapcore.Level
+	if err := level.UnmarshalText([]byte(cfg.Level)); err != nil {
+		return nil, fmt.Errorf("buildCore: invalid level %q: %w", cfg.Level, err)
+	}
+
+	var stacktraceLevel zapcore.Level
+	if err := stacktraceLevel.UnmarshalText([]byte(cfg.StacktraceLevel)); err != nil {
+		return nil, fmt.Errorf("buildCore: invalid stacktrace level %q: %w", cfg.StacktraceLevel, err)
+	}
+
+	encoder := buildEncoder(cfg.Encoding)
+	writer := buildWriteSyncer(cfg)
+
+	core := zapcore.NewCore(encoder, writer, level)
+
+	if cfg.Sampling.Initial > 0 {
+		core = zapcore.NewSamplerWithOptions(core, samplingTick, cfg.Sampling.Initial, cfg.Sampling.Thereafter)
+	}
+
+	return core, nil
+}
+
+func buildEncoder(encoding Encoding) zapcore.Encoder {
+	encoderCfg := zap.NewProductionEncoderConfig()
+	encoderCfg.TimeKey = "ts"
+	encoderCfg.EncodeTime = zapcore.ISO8601TimeEncoder
+
+	if encoding == EncodingConsole {
+		encoderCfg.EncodeLevel = zapcore.CapitalColorLevelEncoder
+		return zapcore.NewConsoleEncoder(encoderCfg)
+	}
+
+	encoderCfg.EncodeLevel = zapcore.CapitalLevelEncoder
+	return zapcore.NewJSONEncoder(encoderCfg)
+}
+
+func buildWriteSyncer(cfg LogConfig) zapcore.WriteSyncer {
+	paths := cfg.OutputPaths
+	if len(paths) == 0 {
+		paths = []string{"stdout"}
+	}
+
+	syncers := make([]zapcore.WriteSyncer, 0, len(paths))
+	for _, path := range paths {
+		switch path {
+		case "stdout":
+			syncers = append(syncers, zapcore.AddSync(os.Stdout))
+		case "stderr":
+			syncers = append(syncers, zapcore.AddSync(os.Stderr))
+		default:
+			syncers = append(syncers, zapcore.AddSync(&lumberjack.Logger{
+				Filename:   path,
+				MaxSize:    cfg.Rotation.MaxSizeMB,
+				MaxAge:     cfg.Rotation.MaxAgeDays,
+				MaxBackups: cfg.Rotation.MaxBackups,
+			}))
+		}
+	}
+
+	return zap.CombineWriteSyncers(syncers...)
+}
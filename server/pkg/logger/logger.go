@@ -3,10 +3,11 @@ package logger
 
 import (
 	"context"
-	"fmt"
 
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
-	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
 )
 
 const (
@@ -18,42 +19,114 @@ type Logger struct {
 	l *zap.Logger
 }
 
-func NewLoggerContext(ctx context.Context) (context.Context, error) {
-	config := zap.NewDevelopmentConfig()
-	config.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
-	logger, err := config.Build()
+var global *Logger
+
+// Setup builds the process-wide logger from cfg. It must be called once,
+// early in main, before any package-level L() or GetLoggerFromCtx call.
+func Setup(cfg LogConfig) error {
+	core, err := buildCore(cfg)
 	if err != nil {
-		return nil, fmt.Errorf("NewLogger: %w", err)
+		return err
+	}
+
+	opts := []zap.Option{
+		zap.AddCaller(),
+		zap.AddStacktrace(mustLevel(cfg.StacktraceLevel)),
+	}
+
+	global = &Logger{l: zap.New(core, opts...)}
+	return nil
+}
+
+// L returns the global logger, falling back to an unconfigured development
+// logger if Setup was never called (e.g. in tests).
+func L() *Logger {
+	if global == nil {
+		fallback, _ := zap.NewDevelopment()
+		global = &Logger{l: fallback}
 	}
+	return global
+}
+
+// WithRequestID attaches requestID to ctx for later retrieval by Logger.With.
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, RequestID, requestID)
+}
 
-	ctx = context.WithValue(ctx, Key, &Logger{logger})
-	return ctx, nil
+// WithFields returns a ctx carrying a child logger with fields attached, so
+// that GetLoggerFromCtx(ctx) picks them up for every subsequent log call.
+func WithFields(ctx context.Context, fields ...zap.Field) context.Context {
+	child := GetLoggerFromCtx(ctx).with(fields...)
+	return context.WithValue(ctx, Key, child)
 }
 
+// GetLoggerFromCtx returns the logger attached to ctx by WithFields, or the
+// global logger if none was attached.
 func GetLoggerFromCtx(ctx context.Context) *Logger {
-	return ctx.Value(Key).(*Logger)
+	if l, ok := ctx.Value(Key).(*Logger); ok {
+		return l
+	}
+	return L()
 }
 
-func NewContextWithLogger(ctx context.Context, log *Logger) context.Context{
-	ctx = context.WithValue(ctx, Key, log)
-	return ctx
+func (l *Logger) with(fields ...zap.Field) *Logger {
+	if len(fields) == 0 {
+		return l
+	}
+	return &Logger{l: l.l.With(fields...)}
 }
 
-func WithRequestID(ctx context.Context, request_id string) context.Context{
-	ctx = context.WithValue(ctx, RequestID, request_id)
-	return ctx
+// With derives a child logger carrying request_id, the gRPC method and the
+// peer address found in ctx, plus trace_id/span_id when an OpenTelemetry
+// span is active.
+func (l *Logger) With(ctx context.Context) *Logger {
+	fields := make([]zap.Field, 0, 5)
+
+	if reqID, ok := ctx.Value(RequestID).(string); ok && reqID != "" {
+		fields = append(fields, zap.String(RequestID, reqID))
+	}
+	if method, ok := grpc.Method(ctx); ok {
+		fields = append(fields, zap.String("method", method))
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		fields = append(fields, zap.String("peer.addr", p.Addr.String()))
+	}
+	if span := trace.SpanContextFromContext(ctx); span.IsValid() {
+		fields = append(fields,
+			zap.String("trace_id", span.TraceID().String()),
+			zap.String("span_id", span.SpanID().String()),
+		)
+	}
+
+	return l.with(fields...)
+}
+
+func (l *Logger) Debug(ctx context.Context, msg string, fields ...zap.Field) {
+	l.With(ctx).l.Debug(msg, fields...)
 }
 
 func (l *Logger) Info(ctx context.Context, msg string, fields ...zap.Field) {
-	if ctx.Value(RequestID) != nil {
-		fields = append(fields, zap.String(RequestID, ctx.Value(RequestID).(string)))
-	}
-	l.l.Info(msg, fields...)
+	l.With(ctx).l.Info(msg, fields...)
+}
+
+func (l *Logger) Warn(ctx context.Context, msg string, fields ...zap.Field) {
+	l.With(ctx).l.Warn(msg, fields...)
 }
 
 func (l *Logger) Error(ctx context.Context, msg string, fields ...zap.Field) {
-	if ctx.Value(RequestID) != nil {
-		fields = append(fields, zap.String(RequestID, ctx.Value(RequestID).(string)))
+	l.With(ctx).l.Error(msg, fields...)
+}
+
+// Sync flushes the global logger's buffered entries. Entries logged after
+// it returns (e.g. by later shutdown hooks) are not flushed by this call.
+func Sync() error {
+	return L().l.Sync()
+}
+
+func mustLevel(raw string) zap.AtomicLevel {
+	lvl := zap.NewAtomicLevel()
+	if err := lvl.UnmarshalText([]byte(raw)); err != nil {
+		lvl.SetLevel(zap.ErrorLevel)
 	}
-	l.l.Error(msg, fields...)
+	return lvl
 }